@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -12,6 +13,9 @@ import (
 
 type APIHandler struct {
 	dynamicConfig *config.DynamicConfig
+	// nullMissingFields is the null mode used when a request does not set
+	// null_missing itself
+	nullMissingFields bool
 }
 
 func NewAPIHandler(dc *config.DynamicConfig) *APIHandler {
@@ -20,16 +24,39 @@ func NewAPIHandler(dc *config.DynamicConfig) *APIHandler {
 	}
 }
 
+// NewNullModeAPIHandler returns a handler that returns null for fields the
+// site leaves blank instead of placeholder values, as v2 does
+func NewNullModeAPIHandler(dc *config.DynamicConfig) *APIHandler {
+	return &APIHandler{
+		dynamicConfig:     dc,
+		nullMissingFields: true,
+	}
+}
+
 func SetupRoutes(r *gin.RouterGroup, dc *config.DynamicConfig) {
-	handler := NewAPIHandler(dc)
+	RegisterRoutes(r, NewAPIHandler(dc))
+}
 
+// RegisterRoutes registers every endpoint of handler on r
+func RegisterRoutes(r *gin.RouterGroup, handler *APIHandler) {
 	r.GET("/home", handler.GetHome)
+	r.GET("/home/sections", handler.GetHomeSections)
 	r.GET("/anime-terbaru", handler.GetAnimeTerbaru)
 	r.GET("/movie", handler.GetMovies)
+	r.GET("/donghua", handler.GetDonghua)
+	r.GET("/tv-show", handler.GetTVShows)
+	r.GET("/drama", handler.GetDrama)
+	r.GET("/genres", handler.GetGenres)
+	r.GET("/genres/:slug", handler.GetGenre)
 	r.GET("/jadwal-rilis", handler.GetSchedule)
 	r.GET("/jadwal-rilis/:day", handler.GetScheduleByDay)
 	r.GET("/search", handler.GetSearch)
+	r.GET("/catalog", handler.GetCatalog)
+	r.GET("/filters", handler.GetFilters)
+	r.GET("/az", handler.GetAZSummary)
+	r.GET("/az/:letter", handler.GetAZLetter)
 	r.GET("/anime-detail", handler.GetAnimeDetail)
+	r.GET("/franchise", handler.GetFranchise)
 	r.GET("/episode-detail", handler.GetEpisodeDetail)
 }
 
@@ -39,12 +66,14 @@ func SetupRoutes(r *gin.RouterGroup, dc *config.DynamicConfig) {
 // @Tags Homepage
 // @Accept json
 // @Produce json
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.HomeResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/home [get]
+// @Router /api/v2/home [get]
 func (h *APIHandler) GetHome(c *gin.Context) {
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	homeScraper := scrapers.NewHomeScraper(cfg)
 	
 	data, err := homeScraper.ScrapeHome()
@@ -60,6 +89,34 @@ func (h *APIHandler) GetHome(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
+// GetHomeSections handles GET /api/v1/home/sections
+// @Summary Get homepage sections
+// @Description Mengambil semua section homepage secara generik beserta judul, link "more" dan item-itemnya
+// @Tags Homepage
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.HomeSectionsResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/home/sections [get]
+// @Router /api/v2/home/sections [get]
+func (h *APIHandler) GetHomeSections(c *gin.Context) {
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	homeScraper := scrapers.NewHomeScraper(cfg)
+
+	data, err := homeScraper.ScrapeHomeSections()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape home sections: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
 // GetAnimeTerbaru handles GET /api/v1/anime-terbaru?page=<int>
 // @Summary Get anime terbaru
 // @Description Mengambil daftar anime terbaru dengan pagination
@@ -67,9 +124,12 @@ func (h *APIHandler) GetHome(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param page query int false "Nomor halaman" default(1)
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.AnimeTerbaruResponse
+// @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/anime-terbaru [get]
+// @Router /api/v2/anime-terbaru [get]
 func (h *APIHandler) GetAnimeTerbaru(c *gin.Context) {
 	pageStr := c.DefaultQuery("page", "1")
 	page, err := strconv.Atoi(pageStr)
@@ -78,12 +138,12 @@ func (h *APIHandler) GetAnimeTerbaru(c *gin.Context) {
 	}
 
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	animeScraper := scrapers.NewAnimeScraper(cfg)
 	
 	data, err := animeScraper.ScrapeAnimeTerbaru(page)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
 			Error:           true,
 			Message:         "Failed to scrape anime terbaru data: " + err.Error(),
 			ConfidenceScore: 0.0,
@@ -101,9 +161,12 @@ func (h *APIHandler) GetAnimeTerbaru(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param page query int false "Nomor halaman" default(1)
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.MovieResponse
+// @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/movie [get]
+// @Router /api/v2/movie [get]
 func (h *APIHandler) GetMovies(c *gin.Context) {
 	pageStr := c.DefaultQuery("page", "1")
 	page, err := strconv.Atoi(pageStr)
@@ -112,12 +175,12 @@ func (h *APIHandler) GetMovies(c *gin.Context) {
 	}
 
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	movieScraper := scrapers.NewMovieScraper(cfg)
 	
 	data, err := movieScraper.ScrapeMovies(page)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
 			Error:           true,
 			Message:         "Failed to scrape movie data: " + err.Error(),
 			ConfidenceScore: 0.0,
@@ -128,6 +191,202 @@ func (h *APIHandler) GetMovies(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
+// GetDonghua handles GET /api/v1/donghua?page=<int>
+// @Summary Get donghua
+// @Description Mengambil daftar anime donghua (animasi China) dengan pagination
+// @Tags Anime
+// @Accept json
+// @Produce json
+// @Param page query int false "Nomor halaman" default(1)
+// @Success 200 {object} models.AnimeTerbaruResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/donghua [get]
+// @Router /api/v2/donghua [get]
+func (h *APIHandler) GetDonghua(c *gin.Context) {
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	donghuaScraper := scrapers.NewDonghuaScraper(cfg)
+
+	data, err := donghuaScraper.ScrapeDonghua(page)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape donghua data: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetTVShows handles GET /api/v1/tv-show?page=<int>
+// @Summary Get TV shows
+// @Description Mengambil daftar TV show dengan pagination
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param page query int false "Nomor halaman" default(1)
+// @Success 200 {object} models.SeriesListResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/tv-show [get]
+// @Router /api/v2/tv-show [get]
+func (h *APIHandler) GetTVShows(c *gin.Context) {
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	tvShowScraper := scrapers.NewTVShowScraper(cfg)
+
+	data, err := tvShowScraper.ScrapeTVShows(page)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape tv show data: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetDrama handles GET /api/v1/drama?page=<int>&region=<string>
+// @Summary Get live-action drama
+// @Description Mengambil daftar drama live-action (Jepang, Korea, China, Barat) dengan pagination
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param page query int false "Nomor halaman" default(1)
+// @Param region query string false "Region (jepang, korea, china, barat). Kosongkan untuk semua region"
+// @Success 200 {object} models.SeriesListResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/drama [get]
+// @Router /api/v2/drama [get]
+func (h *APIHandler) GetDrama(c *gin.Context) {
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	region := c.Query("region")
+	if !scrapers.IsValidDramaRegion(region) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:           true,
+			Message:         "Invalid region: " + region + ". Valid regions are: jepang, korea, china, barat",
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	dramaScraper := scrapers.NewDramaScraper(cfg)
+
+	data, err := dramaScraper.ScrapeDrama(page, region)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape drama data: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetGenres handles GET /api/v1/genres
+// @Summary Get genres
+// @Description Mengambil semua genre beserta slug dan jumlah judulnya
+// @Tags Genre
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.GenresResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/genres [get]
+// @Router /api/v2/genres [get]
+func (h *APIHandler) GetGenres(c *gin.Context) {
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	genreScraper := scrapers.NewGenreScraper(cfg)
+
+	data, err := genreScraper.ScrapeGenres()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape genres: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetGenre handles GET /api/v1/genres/:slug?page=<int>
+// @Summary Get titles by genre
+// @Description Mengambil daftar judul pada genre tertentu dengan pagination
+// @Tags Genre
+// @Accept json
+// @Produce json
+// @Param slug path string true "Slug genre (contoh: action)"
+// @Param page query int false "Nomor halaman" default(1)
+// @Success 200 {object} models.GenreListResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/genres/{slug} [get]
+// @Router /api/v2/genres/{slug} [get]
+func (h *APIHandler) GetGenre(c *gin.Context) {
+	slug := c.Param("slug")
+	if slug == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:           true,
+			Message:         "Genre slug is required",
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	genreScraper := scrapers.NewGenreScraper(cfg)
+
+	data, err := genreScraper.ScrapeGenre(slug, page)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape genre data: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
 // GetSchedule handles GET /api/v1/jadwal-rilis
 // @Summary Get jadwal rilis
 // @Description Mengambil jadwal rilis anime per hari
@@ -137,9 +396,10 @@ func (h *APIHandler) GetMovies(c *gin.Context) {
 // @Success 200 {object} models.ScheduleResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/jadwal-rilis [get]
+// @Router /api/v2/jadwal-rilis [get]
 func (h *APIHandler) GetSchedule(c *gin.Context) {
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	scheduleScraper := scrapers.NewScheduleScraper(cfg)
 	
 	data, err := scheduleScraper.ScrapeSchedule()
@@ -155,17 +415,22 @@ func (h *APIHandler) GetSchedule(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
-// GetSearch handles GET /api/v1/search?q=<string>&page=<int>
+// GetSearch handles GET /api/v1/search?query=<string>&page=<int>&max_pages=<int>
 // @Summary Search anime
-// @Description Mencari anime berdasarkan judul
+// @Description Mencari anime berdasarkan judul. Dengan max_pages > 1, beberapa halaman hasil diambil sekaligus lalu digabung tanpa duplikat
 // @Tags Search
 // @Accept json
 // @Produce json
 // @Param query query string true "Query pencarian"
+// @Param page query int false "Nomor halaman awal" default(1)
+// @Param max_pages query int false "Jumlah halaman yang digabung (maksimal 10)" default(1)
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.SearchResponse
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/search [get]
+// @Router /api/v2/search [get]
 func (h *APIHandler) GetSearch(c *gin.Context) {
 	query := c.Query("query")
 	if query == "" {
@@ -177,13 +442,37 @@ func (h *APIHandler) GetSearch(c *gin.Context) {
 		return
 	}
 
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	maxPages := 1
+	if maxPagesStr := c.Query("max_pages"); maxPagesStr != "" {
+		maxPages, err = strconv.Atoi(maxPagesStr)
+		if err != nil || maxPages < 1 {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:           true,
+				Message:         "Query parameter 'max_pages' must be a positive integer",
+				ConfidenceScore: 0.0,
+			})
+			return
+		}
+	}
+
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	searchScraper := scrapers.NewSearchScraper(cfg)
-	
-	data, err := searchScraper.SearchAnime(query, 1)
+
+	var data *models.SearchResponse
+	if maxPages > 1 {
+		data, err = searchScraper.SearchAnimePages(query, page, maxPages)
+	} else {
+		data, err = searchScraper.SearchAnime(query, page)
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
 			Error:           true,
 			Message:         "Failed to search data: " + err.Error(),
 			ConfidenceScore: 0.0,
@@ -194,6 +483,175 @@ func (h *APIHandler) GetSearch(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
+// GetCatalog handles GET /api/v1/catalog?genre[]=<string>&status=<string>&type=<string>&order=<string>&title=<string>&page=<int>
+// @Summary Browse catalog
+// @Description Menelusuri daftar anime dengan filter genre, status, tipe, urutan, dan judul
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param genre[] query []string false "Slug genre (bisa diulang, contoh: action)" collectionFormat(multi)
+// @Param status query string false "Status tayang (contoh: Currently Airing, Finished Airing)"
+// @Param type query string false "Tipe (contoh: TV, Movie, OVA)"
+// @Param order query string false "Urutan (contoh: popular, latest, title)"
+// @Param title query string false "Judul yang dicari"
+// @Param page query int false "Nomor halaman" default(1)
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
+// @Success 200 {object} models.CatalogResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/catalog [get]
+// @Router /api/v2/catalog [get]
+func (h *APIHandler) GetCatalog(c *gin.Context) {
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	filter := models.CatalogFilter{
+		Title:  c.Query("title"),
+		Status: c.Query("status"),
+		Type:   c.Query("type"),
+		Order:  c.Query("order"),
+		Genres: c.QueryArray("genre[]"),
+		Page:   page,
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+
+	// Reject filter values the site doesn't offer
+	filterScraper := scrapers.NewFilterScraper(cfg)
+	if err := filterScraper.ValidateCatalogFilter(filter); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:           true,
+			Message:         err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	searchScraper := scrapers.NewSearchScraper(cfg)
+
+	data, err := searchScraper.BrowseCatalog(filter)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to browse catalog: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetFilters handles GET /api/v1/filters
+// @Summary Get filter options
+// @Description Mengambil semua opsi filter (status, tipe, urutan, genre) yang valid untuk endpoint katalog
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.FiltersResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/filters [get]
+// @Router /api/v2/filters [get]
+func (h *APIHandler) GetFilters(c *gin.Context) {
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	filterScraper := scrapers.NewFilterScraper(cfg)
+
+	data, err := filterScraper.ScrapeFilters()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape filter options: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetAZSummary handles GET /api/v1/az
+// @Summary Get A-Z index summary
+// @Description Mengambil jumlah judul untuk setiap huruf A-Z (judul berawalan angka/simbol dikelompokkan ke "0-9"). Hasil di-cache beberapa jam
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.AZSummaryResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/az [get]
+// @Router /api/v2/az [get]
+func (h *APIHandler) GetAZSummary(c *gin.Context) {
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	azScraper := scrapers.NewAZScraper(cfg)
+
+	data, err := azScraper.ScrapeAZSummary()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to build A-Z index: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetAZLetter handles GET /api/v1/az/:letter?page=<int>
+// @Summary Get titles by letter
+// @Description Mengambil daftar judul yang berawalan huruf tertentu dengan pagination
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param letter path string true "Huruf A-Z atau 0-9"
+// @Param page query int false "Nomor halaman" default(1)
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
+// @Success 200 {object} models.AZLetterResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/az/{letter} [get]
+// @Router /api/v2/az/{letter} [get]
+func (h *APIHandler) GetAZLetter(c *gin.Context) {
+	letter := scrapers.NormalizeAZLetter(c.Param("letter"))
+	if letter == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:           true,
+			Message:         "Invalid letter. Valid letters are A-Z and 0-9",
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	pageStr := c.DefaultQuery("page", "1")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	azScraper := scrapers.NewAZScraper(cfg)
+
+	data, err := azScraper.ScrapeAZLetter(letter, page)
+	if err != nil {
+		c.JSON(scrapeErrorStatus(err), models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to build A-Z index: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
 // GetAnimeDetail handles GET /api/v1/anime-detail?anime_slug=<string>
 // @Summary Get anime/movie/series detail
 // @Description Mengambil detail anime, film, atau series termasuk episode, sinopsis, dan rekomendasi. Slug dapat berupa 'nama-anime', 'film/nama-film', atau 'series/nama-series'
@@ -201,10 +659,12 @@ func (h *APIHandler) GetSearch(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param anime_slug query string true "Anime/Movie/Series slug (contoh: 'kobane-2022', 'film/kobane-2022', 'series/legend-of-the-female-general')"
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.AnimeDetailResponse
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/anime-detail [get]
+// @Router /api/v2/anime-detail [get]
 func (h *APIHandler) GetAnimeDetail(c *gin.Context) {
 	animeSlug := c.Query("anime_slug")
 	if animeSlug == "" {
@@ -217,7 +677,7 @@ func (h *APIHandler) GetAnimeDetail(c *gin.Context) {
 	}
 
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	detailScraper := scrapers.NewDetailScraper(cfg)
 	
 	data, err := detailScraper.ScrapeAnimeDetail(animeSlug)
@@ -233,6 +693,46 @@ func (h *APIHandler) GetAnimeDetail(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
+// GetFranchise handles GET /api/v1/franchise?anime_slug=<string>
+// @Summary Get franchise entries
+// @Description Mengambil semua season, movie dan special dari satu franchise berdasarkan judul dan rekomendasi
+// @Tags Detail
+// @Accept json
+// @Produce json
+// @Param anime_slug query string true "Anime slug (contoh: 'shingeki-no-kyojin-season-3')"
+// @Success 200 {object} models.FranchiseResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/franchise [get]
+// @Router /api/v2/franchise [get]
+func (h *APIHandler) GetFranchise(c *gin.Context) {
+	animeSlug := c.Query("anime_slug")
+	if animeSlug == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:           true,
+			Message:         "Query parameter 'anime_slug' is required",
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	// Get fresh config and create scraper
+	cfg := h.requestConfig(c)
+	franchiseScraper := scrapers.NewFranchiseScraper(cfg)
+
+	data, err := franchiseScraper.ScrapeFranchise(animeSlug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:           true,
+			Message:         "Failed to scrape franchise: " + err.Error(),
+			ConfidenceScore: 0.0,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
 // GetEpisodeDetail handles GET /api/v1/episode-detail?episode_url=<string>
 // @Summary Get episode detail
 // @Description Mengambil detail episode termasuk server streaming dan link download
@@ -240,10 +740,12 @@ func (h *APIHandler) GetAnimeDetail(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param episode_url query string true "Full URL episode (contoh: 'https://winbu.net/okiraku-ryoushu-no-tanoshii-ryouchi-bouei-episode-6/')"
+// @Param null_missing query bool false "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)"
 // @Success 200 {object} models.EpisodeDetailResponse
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/episode-detail [get]
+// @Router /api/v2/episode-detail [get]
 func (h *APIHandler) GetEpisodeDetail(c *gin.Context) {
 	episodeURL := c.Query("episode_url")
 	if episodeURL == "" {
@@ -256,7 +758,7 @@ func (h *APIHandler) GetEpisodeDetail(c *gin.Context) {
 	}
 
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	detailScraper := scrapers.NewDetailScraper(cfg)
 	
 	data, err := detailScraper.ScrapeEpisodeDetail(episodeURL)
@@ -283,6 +785,7 @@ func (h *APIHandler) GetEpisodeDetail(c *gin.Context) {
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/jadwal-rilis/{day} [get]
+// @Router /api/v2/jadwal-rilis/{day} [get]
 func (h *APIHandler) GetScheduleByDay(c *gin.Context) {
 	day := c.Param("day")
 	if day == "" {
@@ -295,7 +798,7 @@ func (h *APIHandler) GetScheduleByDay(c *gin.Context) {
 	}
 
 	// Get fresh config and create scraper
-	cfg := h.dynamicConfig.Get()
+	cfg := h.requestConfig(c)
 	scheduleScraper := scrapers.NewScheduleScraper(cfg)
 	
 	data, err := scheduleScraper.ScrapeScheduleByDay(day)
@@ -310,3 +813,23 @@ func (h *APIHandler) GetScheduleByDay(c *gin.Context) {
 
 	c.JSON(http.StatusOK, data)
 }
+
+// scrapeErrorStatus maps a scraper error to an HTTP status: 404 for pages
+// past the end of a listing, 500 for everything else.
+func scrapeErrorStatus(err error) int {
+	if errors.Is(err, scrapers.ErrPageNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// requestConfig returns a fresh copy of the dynamic config with the null mode
+// of this handler applied, which ?null_missing=true|false overrides
+func (h *APIHandler) requestConfig(c *gin.Context) *config.Config {
+	cfg := h.dynamicConfig.Get()
+	cfg.NullMissingFields = h.nullMissingFields
+	if nullMissing, err := strconv.ParseBool(c.Query("null_missing")); err == nil {
+		cfg.NullMissingFields = nullMissing
+	}
+	return cfg
+}
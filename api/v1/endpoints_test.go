@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nabilulilalbab/winbu.tv/scrapers"
+)
+
+func TestScrapeErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"page past the end", fmt.Errorf("failed to visit drama page: %w", fmt.Errorf("page 20: %w", scrapers.ErrPageNotFound)), http.StatusNotFound},
+		{"scrape failure", errors.New("failed to visit drama page: connection refused"), http.StatusInternalServerError},
+		{"unwrapped message", errors.New("page not found"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrapeErrorStatus(tt.err); got != tt.want {
+				t.Errorf("scrapeErrorStatus(%v) = %d; want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
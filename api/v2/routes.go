@@ -0,0 +1,14 @@
+package v2
+
+import (
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nabilulilalbab/winbu.tv/api/v1"
+	"github.com/nabilulilalbab/winbu.tv/config"
+)
+
+// SetupRoutes registers the v2 API. v2 serves the v1 endpoints with null
+// mode on by default: fields the site leaves blank are returned as null
+// instead of placeholder values. Requests can still pass null_missing=false.
+func SetupRoutes(r *gin.RouterGroup, dc *config.DynamicConfig) {
+	v1.RegisterRoutes(r, v1.NewNullModeAPIHandler(dc))
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"regexp"
 	"time"
 )
 
@@ -19,6 +22,45 @@ type Config struct {
 	// Cache settings
 	CacheEnabled bool
 	CacheTTL     time.Duration
+
+	// NullMissingFields makes scrapers return null for fields the site left
+	// blank instead of filling them with placeholder values
+	NullMissingFields bool
+
+	// StripImageResize makes scrapers drop WordPress resize suffixes such as
+	// "-300x450" from image URLs, returning the full-size original
+	StripImageResize bool
+
+	// SynopsisBoilerplate holds regular expressions matching synopsis
+	// paragraphs that are site boilerplate rather than story
+	SynopsisBoilerplate []string
+}
+
+// DefaultSynopsisBoilerplate matches "Nonton ... Sub Indo" and "Streaming di
+// Winbu" credit lines, bare site watermarks, source credits and bare
+// "Sinopsis" headings. Patterns are anchored to whole credit lines so a
+// synopsis paragraph that merely mentions the site is kept.
+var DefaultSynopsisBoilerplate = []string{
+	`(?i)^(nonton|download|streaming)\b.*\bsub(title)?\s*indo`,
+	`(?i)^(nonton|download|streaming)\b.*\bdi\s+winbu(\.(tv|net))?\s*[.!]?$`,
+	`(?i)^winbu(\.(tv|net))?$`,
+	`(?i)^\(?\s*(source|sumber)\s*:`,
+	`(?i)^(sinopsis|synopsis)\s*:?$`,
+}
+
+// ParseSynopsisBoilerplate parses a JSON array of boilerplate patterns and
+// checks that every pattern is a valid regular expression
+func ParseSynopsisBoilerplate(value string) ([]string, error) {
+	var patterns []string
+	if err := json.Unmarshal([]byte(value), &patterns); err != nil {
+		return nil, fmt.Errorf("synopsis boilerplate must be a JSON array of patterns: %v", err)
+	}
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid boilerplate pattern %q: %v", pattern, err)
+		}
+	}
+	return patterns, nil
 }
 
 func Load() *Config {
@@ -36,6 +78,12 @@ func Load() *Config {
 		// Cache settings
 		CacheEnabled: getBoolEnv("CACHE_ENABLED", true),
 		CacheTTL:     getDurationEnv("CACHE_TTL", 5*time.Minute),
+
+		// Image settings
+		StripImageResize: getBoolEnv("STRIP_IMAGE_RESIZE", false),
+
+		// Synopsis settings
+		SynopsisBoilerplate: DefaultSynopsisBoilerplate,
 	}
 }
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -105,6 +106,21 @@ func (dc *DynamicConfig) loadFromDatabase() error {
 	}
 	cfg.CacheTTL = cacheTTL
 
+	// Parse image resize stripping
+	stripImageResizeStr := getConfigValue(configs, "strip_image_resize", "false")
+	cfg.StripImageResize = stripImageResizeStr == "true"
+
+	// Parse synopsis boilerplate patterns
+	cfg.SynopsisBoilerplate = DefaultSynopsisBoilerplate
+	if boilerplateStr, ok := configs["synopsis_boilerplate"]; ok && boilerplateStr != "" {
+		patterns, err := ParseSynopsisBoilerplate(boilerplateStr)
+		if err != nil {
+			log.Printf("Warning: Invalid synopsis_boilerplate value, using defaults: %v", err)
+		} else {
+			cfg.SynopsisBoilerplate = patterns
+		}
+	}
+
 	dc.config = cfg
 	log.Println("✓ Configuration loaded from database")
 	return nil
@@ -122,6 +138,7 @@ func (dc *DynamicConfig) Get() *Config {
 
 	// Return a copy to prevent external modifications
 	cfg := *dc.config
+	cfg.SynopsisBoilerplate = append([]string(nil), dc.config.SynopsisBoilerplate...)
 	return &cfg
 }
 
@@ -177,8 +194,23 @@ func (dc *DynamicConfig) UpdateCacheTTL(ttl time.Duration, updatedBy string) err
 	return dc.Reload()
 }
 
+// UpdateSynopsisBoilerplate updates the synopsis boilerplate patterns and
+// reloads config
+func (dc *DynamicConfig) UpdateSynopsisBoilerplate(patterns []string, updatedBy string) error {
+	value, err := json.Marshal(patterns)
+	if err != nil {
+		return err
+	}
+	return dc.UpdateConfig("synopsis_boilerplate", string(value), updatedBy)
+}
+
 // UpdateConfig updates any config key and reloads
 func (dc *DynamicConfig) UpdateConfig(key, value, updatedBy string) error {
+	if key == "synopsis_boilerplate" {
+		if _, err := ParseSynopsisBoilerplate(value); err != nil {
+			return err
+		}
+	}
 	if err := dc.db.SetConfig(key, value, updatedBy); err != nil {
 		return err
 	}
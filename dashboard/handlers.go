@@ -2,12 +2,15 @@ package dashboard
 
 import (
 	"net/http"
+	"regexp"
 	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nabilulilalbab/winbu.tv/config"
 	"github.com/nabilulilalbab/winbu.tv/database"
+	"github.com/nabilulilalbab/winbu.tv/scrapers"
+	"github.com/nabilulilalbab/winbu.tv/utils"
 )
 
 // Handler manages dashboard and admin endpoints
@@ -322,3 +325,245 @@ func (h *Handler) GetHealthChecks(c *gin.Context) {
 		"data": checks,
 	})
 }
+
+// fillRateDropAlert is the fill rate drop between two data quality runs that
+// raises an alert, e.g. cover going from 0.95 to 0.70
+const fillRateDropAlert = 0.2
+
+// GetDataQuality returns the latest data quality report of every endpoint.
+// Each field carries its fill rate change since the previous report, and
+// fields whose fill rate dropped sharply are listed in alerts.
+func (h *Handler) GetDataQuality(c *gin.Context) {
+	reports, err := database.GetLatestDataQualityReports(2)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": true,
+			"message": "Failed to get data quality reports: " + err.Error(),
+		})
+		return
+	}
+
+	// Reports are ordered newest first within each endpoint
+	latest := make(map[string]database.DataQualityReport)
+	previous := make(map[string]database.DataQualityReport)
+	var endpoints []string
+	for _, report := range reports {
+		if _, ok := latest[report.Endpoint]; !ok {
+			latest[report.Endpoint] = report
+			endpoints = append(endpoints, report.Endpoint)
+		} else {
+			previous[report.Endpoint] = report
+		}
+	}
+
+	data := []gin.H{}
+	alerts := []gin.H{}
+	for _, endpoint := range endpoints {
+		report := latest[endpoint]
+		previousFillRates := make(map[string]float64)
+		if prev, ok := previous[endpoint]; ok {
+			for _, field := range prev.Fields {
+				previousFillRates[field.Field] = field.FillRate
+			}
+		}
+
+		fields := []gin.H{}
+		for _, field := range report.Fields {
+			entry := dataQualityFieldJSON(field)
+			if prevRate, ok := previousFillRates[field.Field]; ok {
+				change := utils.RoundScore(field.FillRate - prevRate)
+				entry["fill_rate_change"] = change
+				if -change >= fillRateDropAlert {
+					alerts = append(alerts, gin.H{
+						"endpoint": endpoint,
+						"field": field.Field,
+						"previous_fill_rate": prevRate,
+						"fill_rate": field.FillRate,
+					})
+				}
+			}
+			fields = append(fields, entry)
+		}
+
+		entry := dataQualityReportJSON(report)
+		entry["fields"] = fields
+		data = append(data, entry)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error": false,
+		"message": "Success",
+		"count": len(data),
+		"running": dataQualityProgressJSON(scrapers.NewDataQualityScraper(h.dynamicConfig.Get()).DataQualityCheckProgress()),
+		"alerts": alerts,
+		"data": data,
+	})
+}
+
+// RunDataQuality starts the data quality checks against every scraper in the
+// background. The reports are stored when the checks finish and show up in
+// GetDataQuality; until then GetDataQuality reports the progress.
+func (h *Handler) RunDataQuality(c *gin.Context) {
+	started, progress := scrapers.NewDataQualityScraper(h.dynamicConfig.Get()).StartDataQualityCheck()
+
+	message := "Data quality check started"
+	if !started {
+		message = "Data quality check already running"
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"error": false,
+		"message": message,
+		"running": dataQualityProgressJSON(progress),
+	})
+}
+
+// GetDataQualityHistory returns the rates of one field of one endpoint over
+// time, e.g. ?endpoint=home&field=cover
+func (h *Handler) GetDataQualityHistory(c *gin.Context) {
+	endpoint := c.Query("endpoint")
+	field := c.DefaultQuery("field", "cover")
+	if endpoint == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": true,
+			"message": "Endpoint is required",
+		})
+		return
+	}
+
+	limitStr := c.DefaultQuery("limit", "30")
+	limit, _ := strconv.Atoi(limitStr)
+	if limit < 1 {
+		limit = 30
+	}
+
+	points, err := database.GetDataQualityHistory(endpoint, field, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": true,
+			"message": "Failed to get data quality history: " + err.Error(),
+		})
+		return
+	}
+
+	history := []gin.H{}
+	for _, point := range points {
+		history = append(history, gin.H{
+			"report_id": point.ReportID,
+			"fill_rate": point.FillRate,
+			"placeholder_rate": point.PlaceholderRate,
+			"valid_rate": point.ValidRate,
+			"created_at": point.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error": false,
+		"message": "Success",
+		"endpoint": endpoint,
+		"field": field,
+		"count": len(history),
+		"data": history,
+	})
+}
+
+func dataQualityReportJSON(report database.DataQualityReport) gin.H {
+	entry := gin.H{
+		"endpoint": report.Endpoint,
+		"items_found": report.ItemsFound,
+		"empty_sections": report.EmptySections,
+		"duplicate_slugs": report.DuplicateSlugs,
+		"broken_covers": report.BrokenCovers,
+		"confidence_score": report.ConfidenceScore,
+		"error_message": report.ErrorMessage,
+	}
+	if !report.CreatedAt.IsZero() {
+		entry["created_at"] = report.CreatedAt.Format("2006-01-02 15:04:05")
+	}
+	return entry
+}
+
+// dataQualityProgressJSON returns the progress of a running check, or nil
+// when none is running
+func dataQualityProgressJSON(progress scrapers.DataQualityProgress) gin.H {
+	if !progress.Running {
+		return nil
+	}
+	return gin.H{
+		"endpoints_checked": progress.Checked,
+		"endpoints_total": progress.Total,
+	}
+}
+
+func dataQualityFieldJSON(field database.DataQualityField) gin.H {
+	return gin.H{
+		"field": field.Field,
+		"checked": field.Checked,
+		"fill_rate": field.FillRate,
+		"placeholder_rate": field.PlaceholderRate,
+		"valid_rate": field.ValidRate,
+	}
+}
+
+// GetSynopsisBoilerplate returns the patterns used to strip boilerplate
+// paragraphs from synopses
+func (h *Handler) GetSynopsisBoilerplate(c *gin.Context) {
+	patterns := h.dynamicConfig.Get().SynopsisBoilerplate
+
+	c.JSON(http.StatusOK, gin.H{
+		"error": false,
+		"message": "Success",
+		"count": len(patterns),
+		"data": patterns,
+	})
+}
+
+// UpdateSynopsisBoilerplate replaces the synopsis boilerplate patterns. An
+// empty list turns boilerplate stripping off.
+func (h *Handler) UpdateSynopsisBoilerplate(c *gin.Context) {
+	var req struct {
+		Patterns []string `json:"patterns"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil || req.Patterns == nil {
+		message := "Invalid request: patterns is required"
+		if err != nil {
+			message = "Invalid request: " + err.Error()
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": true,
+			"message": message,
+		})
+		return
+	}
+
+	for _, pattern := range req.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": true,
+				"message": "Invalid pattern " + strconv.Quote(pattern) + ": " + err.Error(),
+			})
+			return
+		}
+	}
+
+	username := c.GetString("username")
+	if username == "" {
+		username = "admin"
+	}
+
+	if err := h.dynamicConfig.UpdateSynopsisBoilerplate(req.Patterns, username); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": true,
+			"message": "Failed to update synopsis boilerplate: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"error": false,
+		"message": "Synopsis boilerplate updated successfully",
+		"count": len(req.Patterns),
+		"data": req.Patterns,
+	})
+}
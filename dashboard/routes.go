@@ -25,6 +25,15 @@ func SetupRoutes(r *gin.RouterGroup, dc *config.DynamicConfig) {
 
 		// Health checks
 		admin.GET("/health-checks", handler.GetHealthChecks)
+
+		// Data quality
+		admin.GET("/data-quality", handler.GetDataQuality)
+		admin.POST("/data-quality/run", handler.RunDataQuality)
+		admin.GET("/data-quality/history", handler.GetDataQualityHistory)
+
+		// Synopsis boilerplate patterns
+		admin.GET("/synopsis-boilerplate", handler.GetSynopsisBoilerplate)
+		admin.PUT("/synopsis-boilerplate", handler.UpdateSynopsisBoilerplate)
 	}
 }
 
@@ -0,0 +1,217 @@
+package database
+
+import (
+	"time"
+)
+
+// DataQualityReport is the data quality of one endpoint at one point in time
+type DataQualityReport struct {
+	ID              int64
+	Endpoint        string
+	ItemsFound      int
+	EmptySections   int
+	DuplicateSlugs  int
+	BrokenCovers    int
+	ConfidenceScore float64
+	ErrorMessage    string
+	Fields          []DataQualityField
+	CreatedAt       time.Time
+}
+
+// DataQualityField holds the rates of one field of a report. Rates are
+// shares (0.0-1.0) of the Checked values.
+type DataQualityField struct {
+	Field           string
+	Checked         int
+	FillRate        float64
+	PlaceholderRate float64
+	ValidRate       float64
+}
+
+// DataQualityPoint is one field's rates in one report, for trend charts
+type DataQualityPoint struct {
+	ReportID        int64
+	FillRate        float64
+	PlaceholderRate float64
+	ValidRate       float64
+	CreatedAt       time.Time
+}
+
+// RecordDataQualityReport stores a report together with its field rates
+func RecordDataQualityReport(report DataQualityReport) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		INSERT INTO data_quality_reports (endpoint, items_found, empty_sections, duplicate_slugs, broken_covers, confidence_score, error_message)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, report.Endpoint, report.ItemsFound, report.EmptySections, report.DuplicateSlugs, report.BrokenCovers,
+		report.ConfidenceScore, report.ErrorMessage)
+	if err != nil {
+		return err
+	}
+	reportID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	for _, field := range report.Fields {
+		if _, err := tx.Exec(`
+			INSERT INTO data_quality_fields (report_id, field, checked, fill_rate, placeholder_rate, valid_rate)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, reportID, field.Field, field.Checked, field.FillRate, field.PlaceholderRate, field.ValidRate); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// staleDataQualityReports selects the reports older than a cutoff time or
+// beyond the newest reports of their endpoint
+const staleDataQualityReports = `
+	SELECT id FROM data_quality_reports r
+	WHERE created_at < ? OR id NOT IN (
+		SELECT id FROM data_quality_reports
+		WHERE endpoint = r.endpoint
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	)
+`
+
+// PruneDataQualityReports deletes the reports older than maxAge and all but
+// the latest keepPerEndpoint reports of every endpoint, together with their
+// field rates, and returns the number of reports deleted
+func PruneDataQualityReports(keepPerEndpoint int, maxAge time.Duration) (int64, error) {
+	cutoff := time.Now().UTC().Add(-maxAge).Format("2006-01-02 15:04:05")
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM data_quality_fields WHERE report_id IN (`+staleDataQualityReports+`)`,
+		cutoff, keepPerEndpoint); err != nil {
+		return 0, err
+	}
+	result, err := tx.Exec(`DELETE FROM data_quality_reports WHERE id IN (`+staleDataQualityReports+`)`,
+		cutoff, keepPerEndpoint)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, tx.Commit()
+}
+
+// GetLatestDataQualityReports returns the latest perEndpoint reports of
+// every endpoint, newest first within each endpoint
+func GetLatestDataQualityReports(perEndpoint int) ([]DataQualityReport, error) {
+	rows, err := DB.Query(`
+		SELECT id, endpoint, items_found, empty_sections, duplicate_slugs, broken_covers, confidence_score,
+			COALESCE(error_message, ''), created_at
+		FROM data_quality_reports r
+		WHERE id IN (
+			SELECT id FROM data_quality_reports
+			WHERE endpoint = r.endpoint
+			ORDER BY created_at DESC, id DESC
+			LIMIT ?
+		)
+		ORDER BY endpoint, created_at DESC, id DESC
+	`, perEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []DataQualityReport
+	for rows.Next() {
+		var report DataQualityReport
+		if err := rows.Scan(&report.ID, &report.Endpoint, &report.ItemsFound, &report.EmptySections,
+			&report.DuplicateSlugs, &report.BrokenCovers, &report.ConfidenceScore, &report.ErrorMessage,
+			&report.CreatedAt); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range reports {
+		fields, err := getDataQualityFields(reports[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		reports[i].Fields = fields
+	}
+
+	return reports, nil
+}
+
+// GetDataQualityHistory returns the rates of one endpoint field over time,
+// oldest first
+func GetDataQualityHistory(endpoint, field string, limit int) ([]DataQualityPoint, error) {
+	rows, err := DB.Query(`
+		SELECT r.id, f.fill_rate, f.placeholder_rate, f.valid_rate, r.created_at
+		FROM data_quality_fields f
+		JOIN data_quality_reports r ON r.id = f.report_id
+		WHERE r.endpoint = ? AND f.field = ?
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT ?
+	`, endpoint, field, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []DataQualityPoint
+	for rows.Next() {
+		var point DataQualityPoint
+		if err := rows.Scan(&point.ReportID, &point.FillRate, &point.PlaceholderRate, &point.ValidRate, &point.CreatedAt); err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Newest rows were selected so the limit keeps the latest ones
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+
+	return points, nil
+}
+
+func getDataQualityFields(reportID int64) ([]DataQualityField, error) {
+	rows, err := DB.Query(`
+		SELECT field, checked, fill_rate, placeholder_rate, valid_rate
+		FROM data_quality_fields
+		WHERE report_id = ?
+		ORDER BY id
+	`, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []DataQualityField
+	for rows.Next() {
+		var field DataQualityField
+		if err := rows.Scan(&field.Field, &field.Checked, &field.FillRate, &field.PlaceholderRate, &field.ValidRate); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, rows.Err()
+}
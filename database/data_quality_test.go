@@ -0,0 +1,74 @@
+package database
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPruneDataQualityReports(t *testing.T) {
+	schema, err := os.ReadFile("schema.sql")
+	if err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "winbu.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(string(schema)); err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	previous := DB
+	DB = db
+	defer func() { DB = previous }()
+
+	// Four home reports from 1, 2, 3 and 100 days ago and one old movie report
+	now := time.Now().UTC()
+	insert := func(endpoint string, age time.Duration) {
+		result, err := db.Exec(`INSERT INTO data_quality_reports (endpoint, created_at) VALUES (?, ?)`,
+			endpoint, now.Add(-age).Format("2006-01-02 15:04:05"))
+		if err != nil {
+			t.Fatalf("Failed to insert report: %v", err)
+		}
+		id, _ := result.LastInsertId()
+		if _, err := db.Exec(`INSERT INTO data_quality_fields (report_id, field) VALUES (?, 'cover')`, id); err != nil {
+			t.Fatalf("Failed to insert field: %v", err)
+		}
+	}
+	day := 24 * time.Hour
+	insert("home", 100*day)
+	insert("home", 3*day)
+	insert("home", 2*day)
+	insert("home", 1*day)
+	insert("movie", 100*day)
+
+	deleted, err := PruneDataQualityReports(2, 90*day)
+	if err != nil {
+		t.Fatalf("Failed to prune reports: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("Deleted %d reports; want 3", deleted)
+	}
+
+	reports, err := GetLatestDataQualityReports(10)
+	if err != nil {
+		t.Fatalf("Failed to get reports: %v", err)
+	}
+	if len(reports) != 2 || reports[0].Endpoint != "home" || reports[1].Endpoint != "home" {
+		t.Fatalf("Kept %+v; want the two newest home reports", reports)
+	}
+	if age := now.Sub(reports[1].CreatedAt); age > 2*day+time.Hour {
+		t.Errorf("Oldest kept report is %v old; want the one from 2 days ago", age)
+	}
+
+	var fields int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM data_quality_fields`).Scan(&fields); err != nil {
+		t.Fatalf("Failed to count fields: %v", err)
+	}
+	if fields != 2 {
+		t.Errorf("Kept %d field rows; want 2", fields)
+	}
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"time"
+)
+
+// ReleaseSlot is the air day/time of a series as listed on the site's
+// release schedule page
+type ReleaseSlot struct {
+	AnimeSlug   string
+	Title       string
+	URL         string
+	CoverURL    string
+	Type        string
+	Episode     string
+	DayOfWeek   string
+	ReleaseTime string
+	TimeKnown   bool
+	Position    int
+	UpdatedAt   time.Time
+}
+
+// ReplaceReleaseSchedule replaces the stored schedule with the slots of the
+// latest successful scrape of the schedule page
+func ReplaceReleaseSchedule(slots []ReleaseSlot) error {
+	tx, err := DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM release_schedule`); err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		if _, err := tx.Exec(`
+			INSERT INTO release_schedule (anime_slug, day_of_week, title, url, cover_url, type, episode, release_time, time_known, position)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT(anime_slug, day_of_week) DO NOTHING
+		`, slot.AnimeSlug, slot.DayOfWeek, slot.Title, slot.URL, slot.CoverURL, slot.Type, slot.Episode,
+			slot.ReleaseTime, slot.TimeKnown, slot.Position); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetReleaseSchedule returns the stored schedule in page order
+func GetReleaseSchedule() ([]ReleaseSlot, error) {
+	rows, err := DB.Query(`
+		SELECT anime_slug, title, url, COALESCE(cover_url, ''), COALESCE(type, ''), COALESCE(episode, ''),
+			day_of_week, COALESCE(release_time, ''), time_known, position, updated_at
+		FROM release_schedule
+		ORDER BY position
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slots []ReleaseSlot
+	for rows.Next() {
+		var slot ReleaseSlot
+		if err := rows.Scan(&slot.AnimeSlug, &slot.Title, &slot.URL, &slot.CoverURL, &slot.Type, &slot.Episode,
+			&slot.DayOfWeek, &slot.ReleaseTime, &slot.TimeKnown, &slot.Position, &slot.UpdatedAt); err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots, rows.Err()
+}
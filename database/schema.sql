@@ -49,6 +49,34 @@ CREATE TABLE IF NOT EXISTS health_checks (
 CREATE INDEX IF NOT EXISTS idx_health_scraper ON health_checks(scraper_name, created_at);
 CREATE INDEX IF NOT EXISTS idx_health_status ON health_checks(status);
 
+-- Data quality reports - one row per endpoint per data quality run
+CREATE TABLE IF NOT EXISTS data_quality_reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    endpoint VARCHAR(100) NOT NULL,
+    items_found INTEGER DEFAULT 0,
+    empty_sections INTEGER DEFAULT 0,
+    duplicate_slugs INTEGER DEFAULT 0,
+    broken_covers INTEGER DEFAULT 0,
+    confidence_score REAL DEFAULT 0.0,
+    error_message TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Data quality fields - per-field rates of each report
+CREATE TABLE IF NOT EXISTS data_quality_fields (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    report_id INTEGER NOT NULL REFERENCES data_quality_reports(id) ON DELETE CASCADE,
+    field VARCHAR(100) NOT NULL,
+    checked INTEGER DEFAULT 0,
+    fill_rate REAL DEFAULT 0.0, -- share of values present and not placeholders
+    placeholder_rate REAL DEFAULT 0.0, -- share of placeholder values
+    valid_rate REAL DEFAULT 0.0 -- share of values passing validation
+);
+
+-- Indexes for data quality
+CREATE INDEX IF NOT EXISTS idx_data_quality_endpoint ON data_quality_reports(endpoint, created_at);
+CREATE INDEX IF NOT EXISTS idx_data_quality_fields_report ON data_quality_fields(report_id, field);
+
 -- Users table - for dashboard authentication
 CREATE TABLE IF NOT EXISTS users (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -68,9 +96,26 @@ INSERT OR IGNORE INTO config (key, value, description, category) VALUES
     ('max_retries', '3', 'Maximum retry attempts', 'scraping'),
     ('cache_enabled', 'true', 'Enable/disable cache', 'cache'),
     ('cache_ttl', '5m', 'Cache time-to-live', 'cache'),
+    ('strip_image_resize', 'false', 'Strip resize suffixes such as -300x450 from image URLs', 'scraping'),
     ('user_agent', 'Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/115.0.0.0 Safari/537.36', 'HTTP User-Agent header', 'scraping');
 
 -- Insert default admin user (password: admin123 - HARUS DIUBAH!)
 -- Password hash for 'admin123' using bcrypt
 INSERT OR IGNORE INTO users (username, password_hash, role) VALUES 
     ('admin', '$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy', 'admin');
+
+-- Release schedule table - last successfully scraped schedule page
+CREATE TABLE IF NOT EXISTS release_schedule (
+    anime_slug VARCHAR(255) NOT NULL,
+    day_of_week VARCHAR(10) NOT NULL, -- Monday ... Sunday (WIB)
+    title TEXT NOT NULL,
+    url TEXT NOT NULL,
+    cover_url TEXT,
+    type VARCHAR(50),
+    episode VARCHAR(100),
+    release_time VARCHAR(5), -- HH:MM (WIB), empty when unknown
+    time_known BOOLEAN DEFAULT 0,
+    position INTEGER NOT NULL, -- order on the schedule page
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (anime_slug, day_of_week)
+);
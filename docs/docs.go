@@ -44,6 +44,12 @@ const docTemplate = `{
                         "name": "anime_slug",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -88,6 +94,12 @@ const docTemplate = `{
                         "description": "Nomor halaman",
                         "name": "page",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -97,6 +109,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.AnimeTerbaruResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -106,9 +124,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/episode-detail": {
+        "/api/v1/az": {
             "get": {
-                "description": "Mengambil detail episode termasuk server streaming dan link download",
+                "description": "Mengambil jumlah judul untuk setiap huruf A-Z (judul berawalan angka/simbol dikelompokkan ke \"0-9\"). Hasil di-cache beberapa jam",
                 "consumes": [
                     "application/json"
                 ],
@@ -116,23 +134,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Detail"
+                    "Catalog"
                 ],
-                "summary": "Get episode detail",
+                "summary": "Get A-Z index summary",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AZSummaryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/az/{letter}": {
+            "get": {
+                "description": "Mengambil daftar judul yang berawalan huruf tertentu dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Catalog"
+                ],
+                "summary": "Get titles by letter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Full URL episode (contoh: 'https://winbu.net/okiraku-ryoushu-no-tanoshii-ryouchi-bouei-episode-6/')",
-                        "name": "episode_url",
-                        "in": "query",
+                        "description": "Huruf A-Z atau 0-9",
+                        "name": "letter",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EpisodeDetailResponse"
+                            "$ref": "#/definitions/models.AZLetterResponse"
                         }
                     },
                     "400": {
@@ -141,6 +201,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -150,9 +216,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/home": {
+        "/api/v1/catalog": {
             "get": {
-                "description": "Mengambil data homepage termasuk top 10 anime, episode terbaru, film terbaru, dan jadwal rilis",
+                "description": "Menelusuri daftar anime dengan filter genre, status, tipe, urutan, dan judul",
                 "consumes": [
                     "application/json"
                 ],
@@ -160,14 +226,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Homepage"
+                    "Search"
+                ],
+                "summary": "Browse catalog",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Slug genre (bisa diulang, contoh: action)",
+                        "name": "genre[]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status tayang (contoh: Currently Airing, Finished Airing)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipe (contoh: TV, Movie, OVA)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan (contoh: popular, latest, title)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Judul yang dicari",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get homepage data",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.HomeResponse"
+                            "$ref": "#/definitions/models.CatalogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -179,9 +306,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/jadwal-rilis": {
+        "/api/v1/donghua": {
             "get": {
-                "description": "Mengambil jadwal rilis anime per hari",
+                "description": "Mengambil daftar anime donghua (animasi China) dengan pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -189,14 +316,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Anime"
+                ],
+                "summary": "Get donghua",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get jadwal rilis",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ScheduleResponse"
+                            "$ref": "#/definitions/models.AnimeTerbaruResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -208,9 +350,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/jadwal-rilis/{day}": {
+        "/api/v1/drama": {
             "get": {
-                "description": "Mengambil jadwal rilis anime untuk hari tertentu",
+                "description": "Mengambil daftar drama live-action (Jepang, Korea, China, Barat) dengan pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -218,23 +360,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Series"
                 ],
-                "summary": "Get jadwal rilis by day",
+                "summary": "Get live-action drama",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Nama hari (monday, tuesday, wednesday, thursday, friday, saturday, sunday)",
-                        "name": "day",
-                        "in": "path",
-                        "required": true
+                        "description": "Region (jepang, korea, china, barat). Kosongkan untuk semua region",
+                        "name": "region",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DayScheduleResponse"
+                            "$ref": "#/definitions/models.SeriesListResponse"
                         }
                     },
                     "400": {
@@ -243,6 +391,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -252,9 +406,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/movie": {
+        "/api/v1/episode-detail": {
             "get": {
-                "description": "Mengambil daftar film dengan pagination",
+                "description": "Mengambil detail episode termasuk server streaming dan link download",
                 "consumes": [
                     "application/json"
                 ],
@@ -262,15 +416,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Movies"
+                    "Detail"
                 ],
-                "summary": "Get movies",
+                "summary": "Get episode detail",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Nomor halaman",
-                        "name": "page",
+                        "type": "string",
+                        "description": "Full URL episode (contoh: 'https://winbu.net/okiraku-ryoushu-no-tanoshii-ryouchi-bouei-episode-6/')",
+                        "name": "episode_url",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
                         "in": "query"
                     }
                 ],
@@ -278,7 +438,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MovieResponse"
+                            "$ref": "#/definitions/models.EpisodeDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -290,9 +456,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/search": {
+        "/api/v1/filters": {
             "get": {
-                "description": "Mencari anime berdasarkan judul",
+                "description": "Mengambil semua opsi filter (status, tipe, urutan, genre) yang valid untuk endpoint katalog",
                 "consumes": [
                     "application/json"
                 ],
@@ -302,12 +468,41 @@ const docTemplate = `{
                 "tags": [
                     "Search"
                 ],
-                "summary": "Search anime",
+                "summary": "Get filter options",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FiltersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/franchise": {
+            "get": {
+                "description": "Mengambil semua season, movie dan special dari satu franchise berdasarkan judul dan rekomendasi",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Detail"
+                ],
+                "summary": "Get franchise entries",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Query pencarian",
-                        "name": "query",
+                        "description": "Anime slug (contoh: 'shingeki-no-kyojin-season-3')",
+                        "name": "anime_slug",
                         "in": "query",
                         "required": true
                     }
@@ -316,7 +511,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SearchResponse"
+                            "$ref": "#/definitions/models.FranchiseResponse"
                         }
                     },
                     "400": {
@@ -333,144 +528,1948 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.AnimeDetailResponse": {
-            "type": "object",
-            "properties": {
-                "anime_slug": {
-                    "type": "string"
-                },
-                "confidence_score": {
-                    "type": "number"
-                },
-                "cover": {
-                    "type": "string"
-                },
-                "details": {
-                    "$ref": "#/definitions/models.AnimeDetails"
-                },
-                "episode_list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.EpisodeListItem"
-                    }
-                },
-                "genre": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+        },
+        "/api/v1/genres": {
+            "get": {
+                "description": "Mengambil semua genre beserta slug dan jumlah judulnya",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Get genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GenresResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
                     }
-                },
-                "judul": {
+                }
+            }
+        },
+        "/api/v1/genres/{slug}": {
+            "get": {
+                "description": "Mengambil daftar judul pada genre tertentu dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Get titles by genre",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slug genre (contoh: action)",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GenreListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/home": {
+            "get": {
+                "description": "Mengambil data homepage termasuk top 10 anime, episode terbaru, film terbaru, dan jadwal rilis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Homepage"
+                ],
+                "summary": "Get homepage data",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HomeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/home/sections": {
+            "get": {
+                "description": "Mengambil semua section homepage secara generik beserta judul, link \"more\" dan item-itemnya",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Homepage"
+                ],
+                "summary": "Get homepage sections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HomeSectionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jadwal-rilis": {
+            "get": {
+                "description": "Mengambil jadwal rilis anime per hari",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get jadwal rilis",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScheduleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jadwal-rilis/{day}": {
+            "get": {
+                "description": "Mengambil jadwal rilis anime untuk hari tertentu",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get jadwal rilis by day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama hari (monday, tuesday, wednesday, thursday, friday, saturday, sunday)",
+                        "name": "day",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DayScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/movie": {
+            "get": {
+                "description": "Mengambil daftar film dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Movies"
+                ],
+                "summary": "Get movies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MovieResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/search": {
+            "get": {
+                "description": "Mencari anime berdasarkan judul. Dengan max_pages \u003e 1, beberapa halaman hasil diambil sekaligus lalu digabung tanpa duplikat",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search anime",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query pencarian",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman awal",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Jumlah halaman yang digabung (maksimal 10)",
+                        "name": "max_pages",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tv-show": {
+            "get": {
+                "description": "Mengambil daftar TV show dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get TV shows",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SeriesListResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/anime-detail": {
+            "get": {
+                "description": "Mengambil detail anime, film, atau series termasuk episode, sinopsis, dan rekomendasi. Slug dapat berupa 'nama-anime', 'film/nama-film', atau 'series/nama-series'",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Detail"
+                ],
+                "summary": "Get anime/movie/series detail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Anime/Movie/Series slug (contoh: 'kobane-2022', 'film/kobane-2022', 'series/legend-of-the-female-general')",
+                        "name": "anime_slug",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AnimeDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/anime-terbaru": {
+            "get": {
+                "description": "Mengambil daftar anime terbaru dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anime"
+                ],
+                "summary": "Get anime terbaru",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AnimeTerbaruResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/az": {
+            "get": {
+                "description": "Mengambil jumlah judul untuk setiap huruf A-Z (judul berawalan angka/simbol dikelompokkan ke \"0-9\"). Hasil di-cache beberapa jam",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Catalog"
+                ],
+                "summary": "Get A-Z index summary",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AZSummaryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/az/{letter}": {
+            "get": {
+                "description": "Mengambil daftar judul yang berawalan huruf tertentu dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Catalog"
+                ],
+                "summary": "Get titles by letter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Huruf A-Z atau 0-9",
+                        "name": "letter",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AZLetterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/catalog": {
+            "get": {
+                "description": "Menelusuri daftar anime dengan filter genre, status, tipe, urutan, dan judul",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Browse catalog",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Slug genre (bisa diulang, contoh: action)",
+                        "name": "genre[]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status tayang (contoh: Currently Airing, Finished Airing)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipe (contoh: TV, Movie, OVA)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Urutan (contoh: popular, latest, title)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Judul yang dicari",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CatalogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/donghua": {
+            "get": {
+                "description": "Mengambil daftar anime donghua (animasi China) dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anime"
+                ],
+                "summary": "Get donghua",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AnimeTerbaruResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/drama": {
+            "get": {
+                "description": "Mengambil daftar drama live-action (Jepang, Korea, China, Barat) dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get live-action drama",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region (jepang, korea, china, barat). Kosongkan untuk semua region",
+                        "name": "region",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SeriesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/episode-detail": {
+            "get": {
+                "description": "Mengambil detail episode termasuk server streaming dan link download",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Detail"
+                ],
+                "summary": "Get episode detail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Full URL episode (contoh: 'https://winbu.net/okiraku-ryoushu-no-tanoshii-ryouchi-bouei-episode-6/')",
+                        "name": "episode_url",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EpisodeDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/filters": {
+            "get": {
+                "description": "Mengambil semua opsi filter (status, tipe, urutan, genre) yang valid untuk endpoint katalog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Get filter options",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FiltersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/franchise": {
+            "get": {
+                "description": "Mengambil semua season, movie dan special dari satu franchise berdasarkan judul dan rekomendasi",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Detail"
+                ],
+                "summary": "Get franchise entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Anime slug (contoh: 'shingeki-no-kyojin-season-3')",
+                        "name": "anime_slug",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FranchiseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/genres": {
+            "get": {
+                "description": "Mengambil semua genre beserta slug dan jumlah judulnya",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Get genres",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GenresResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/genres/{slug}": {
+            "get": {
+                "description": "Mengambil daftar judul pada genre tertentu dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Get titles by genre",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slug genre (contoh: action)",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.GenreListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/home": {
+            "get": {
+                "description": "Mengambil data homepage termasuk top 10 anime, episode terbaru, film terbaru, dan jadwal rilis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Homepage"
+                ],
+                "summary": "Get homepage data",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HomeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/home/sections": {
+            "get": {
+                "description": "Mengambil semua section homepage secara generik beserta judul, link \"more\" dan item-itemnya",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Homepage"
+                ],
+                "summary": "Get homepage sections",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HomeSectionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/jadwal-rilis": {
+            "get": {
+                "description": "Mengambil jadwal rilis anime per hari",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get jadwal rilis",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ScheduleResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/jadwal-rilis/{day}": {
+            "get": {
+                "description": "Mengambil jadwal rilis anime untuk hari tertentu",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get jadwal rilis by day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nama hari (monday, tuesday, wednesday, thursday, friday, saturday, sunday)",
+                        "name": "day",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DayScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/movie": {
+            "get": {
+                "description": "Mengambil daftar film dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Movies"
+                ],
+                "summary": "Get movies",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MovieResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/search": {
+            "get": {
+                "description": "Mencari anime berdasarkan judul. Dengan max_pages \u003e 1, beberapa halaman hasil diambil sekaligus lalu digabung tanpa duplikat",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search anime",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query pencarian",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman awal",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Jumlah halaman yang digabung (maksimal 10)",
+                        "name": "max_pages",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Kembalikan null untuk field kosong, bukan nilai placeholder (default false di v1, true di v2)",
+                        "name": "null_missing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/tv-show": {
+            "get": {
+                "description": "Mengambil daftar TV show dengan pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get TV shows",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Nomor halaman",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SeriesListResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AZLetterCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "letter": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AZLetterResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchResultItem"
+                    }
+                },
+                "letter": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AZSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "complete": {
+                    "type": "boolean"
+                },
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AZLetterCount"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AnimeDetailResponse": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "details": {
+                    "$ref": "#/definitions/models.AnimeDetails"
+                },
+                "episode_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EpisodeListItem"
+                    }
+                },
+                "genre": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "judul": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "page_meta": {
+                    "$ref": "#/definitions/models.PageMeta"
+                },
+                "penonton": {
+                    "type": "string"
+                },
+                "rating": {
+                    "$ref": "#/definitions/models.AnimeRating"
+                },
+                "recommendations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RecommendationItem"
+                    }
+                },
+                "score_value": {
+                    "type": "number"
+                },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SeasonGroup"
+                    }
+                },
+                "sinopsis": {
+                    "type": "string"
+                },
+                "sinopsis_markdown": {
+                    "type": "string"
+                },
+                "sinopsis_paragraphs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "skor": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_source": {
                     "type": "string"
                 },
-                "message": {
-                    "type": "string"
+                "tipe": {
+                    "type": "string"
+                },
+                "type_source": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "views_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AnimeDetails": {
+            "type": "object",
+            "properties": {
+                "Duration": {
+                    "type": "string"
+                },
+                "English": {
+                    "type": "string"
+                },
+                "Japanese": {
+                    "type": "string"
+                },
+                "Producers": {
+                    "type": "string"
+                },
+                "Released:": {
+                    "type": "string"
+                },
+                "Season": {
+                    "type": "string"
+                },
+                "Source": {
+                    "type": "string"
+                },
+                "Status": {
+                    "type": "string"
+                },
+                "Studio": {
+                    "type": "string"
+                },
+                "Total Episode": {
+                    "type": "string"
+                },
+                "Type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AnimeInfo": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "synopsis": {
+                    "type": "string"
+                },
+                "synopsis_markdown": {
+                    "type": "string"
+                },
+                "synopsis_paragraphs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AnimeRating": {
+            "type": "object",
+            "properties": {
+                "score": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AnimeTerbaruItem": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "episode": {
+                    "type": "string"
+                },
+                "episode_number": {
+                    "type": "number"
+                },
+                "judul": {
+                    "type": "string"
+                },
+                "released_at": {
+                    "type": "string"
+                },
+                "rilis": {
+                    "type": "string"
+                },
+                "uploader": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AnimeTerbaruResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AnimeTerbaruItem"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AvailableFilters": {
+            "type": "object",
+            "properties": {
+                "genre_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FilterOption"
+                    }
+                },
+                "order_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FilterOption"
+                    }
+                },
+                "status_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FilterOption"
+                    }
+                },
+                "type_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FilterOption"
+                    }
+                }
+            }
+        },
+        "models.CatalogFilter": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "order": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CatalogResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchResultItem"
+                    }
+                },
+                "filters": {
+                    "$ref": "#/definitions/models.CatalogFilter"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConfidenceBreakdown": {
+            "type": "object",
+            "properties": {
+                "error_count": {
+                    "type": "integer"
+                },
+                "error_penalty": {
+                    "type": "number"
+                },
+                "field_score": {
+                    "type": "number"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldConfidence"
+                    }
+                }
+            }
+        },
+        "models.DayScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ScheduleItem"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DownloadLink": {
+            "type": "object",
+            "properties": {
+                "provider": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DownloadLinksGroup": {
+            "type": "object",
+            "properties": {
+                "MKV": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.DownloadLink"
+                        }
+                    }
+                },
+                "MP4": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.DownloadLink"
+                        }
+                    }
                 },
-                "penonton": {
-                    "type": "string"
+                "x265 [Mode Irit Kuota tapi Kualitas Sama Beningnya]": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.DownloadLink"
+                        }
+                    }
+                }
+            }
+        },
+        "models.EpisodeDetailResponse": {
+            "type": "object",
+            "properties": {
+                "anime_info": {
+                    "$ref": "#/definitions/models.AnimeInfo"
                 },
-                "rating": {
-                    "$ref": "#/definitions/models.AnimeRating"
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
                 },
-                "recommendations": {
+                "confidence_score": {
+                    "type": "number"
+                },
+                "defaulted_fields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.RecommendationItem"
+                        "type": "string"
                     }
                 },
-                "sinopsis": {
+                "download_links": {
+                    "$ref": "#/definitions/models.DownloadLinksGroup"
+                },
+                "message": {
                     "type": "string"
                 },
-                "skor": {
+                "navigation": {
+                    "$ref": "#/definitions/models.EpisodeNavigation"
+                },
+                "other_episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OtherEpisode"
+                    }
+                },
+                "page_meta": {
+                    "$ref": "#/definitions/models.PageMeta"
+                },
+                "release_info": {
                     "type": "string"
                 },
                 "source": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "streaming_servers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StreamingServer"
+                    }
                 },
-                "tipe": {
+                "thumbnail_url": {
                     "type": "string"
                 },
-                "url": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "models.AnimeDetails": {
+        "models.EpisodeListItem": {
             "type": "object",
             "properties": {
-                "Duration": {
+                "episode": {
                     "type": "string"
                 },
-                "English": {
-                    "type": "string"
+                "episode_number": {
+                    "type": "number"
                 },
-                "Japanese": {
+                "episode_slug": {
                     "type": "string"
                 },
-                "Producers": {
+                "episode_type": {
                     "type": "string"
                 },
-                "Released:": {
+                "release_date": {
                     "type": "string"
                 },
-                "Season": {
+                "released_at": {
                     "type": "string"
                 },
-                "Source": {
+                "title": {
                     "type": "string"
                 },
-                "Status": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EpisodeNavigation": {
+            "type": "object",
+            "properties": {
+                "all_episodes_url": {
                     "type": "string"
                 },
-                "Studio": {
+                "next_episode_url": {
                     "type": "string"
                 },
-                "Total Episode": {
+                "previous_episode_url": {
                     "type": "string"
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_score": {
+                    "type": "number"
                 },
-                "Type": {
+                "error": {
+                    "type": "boolean"
+                },
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "models.AnimeInfo": {
+        "models.FieldConfidence": {
             "type": "object",
             "properties": {
-                "genres": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "checked": {
+                    "type": "integer"
                 },
-                "synopsis": {
+                "field": {
                     "type": "string"
                 },
-                "thumbnail_url": {
+                "issues": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "score": {
+                    "type": "number"
+                },
+                "valid": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.FilterOption": {
+            "type": "object",
+            "properties": {
+                "display_name": {
                     "type": "string"
                 },
-                "title": {
+                "query_value": {
                     "type": "string"
                 }
             }
         },
-        "models.AnimeRating": {
+        "models.FiltersResponse": {
             "type": "object",
             "properties": {
-                "score": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "$ref": "#/definitions/models.AvailableFilters"
+                },
+                "message": {
                     "type": "string"
                 },
-                "users": {
+                "source": {
                     "type": "string"
                 }
             }
         },
-        "models.AnimeTerbaruItem": {
+        "models.FranchiseEntry": {
             "type": "object",
             "properties": {
                 "anime_slug": {
@@ -479,16 +2478,16 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
-                "episode": {
+                "found_via": {
                     "type": "string"
                 },
-                "judul": {
-                    "type": "string"
+                "is_current": {
+                    "type": "boolean"
                 },
-                "rilis": {
-                    "type": "string"
+                "season_number": {
+                    "type": "integer"
                 },
-                "uploader": {
+                "title": {
                     "type": "string"
                 },
                 "url": {
@@ -496,16 +2495,25 @@ const docTemplate = `{
                 }
             }
         },
-        "models.AnimeTerbaruResponse": {
+        "models.FranchiseResponse": {
             "type": "object",
             "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "base_title": {
+                    "type": "string"
+                },
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.AnimeTerbaruItem"
+                        "$ref": "#/definitions/models.FranchiseEntry"
                     }
                 },
                 "message": {
@@ -516,106 +2524,123 @@ const docTemplate = `{
                 }
             }
         },
-        "models.DayScheduleResponse": {
+        "models.Genre": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GenreListResponse": {
             "type": "object",
             "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.ScheduleItem"
+                        "$ref": "#/definitions/models.SeriesListItem"
                     }
                 },
+                "genre": {
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
                 "source": {
                     "type": "string"
                 }
             }
         },
-        "models.DownloadLink": {
+        "models.GenresResponse": {
             "type": "object",
             "properties": {
-                "provider": {
-                    "type": "string"
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
                 },
-                "url": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.DownloadLinksGroup": {
-            "type": "object",
-            "properties": {
-                "MKV": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/models.DownloadLink"
-                        }
-                    }
+                "confidence_score": {
+                    "type": "number"
                 },
-                "MP4": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/models.DownloadLink"
-                        }
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Genre"
                     }
                 },
-                "x265 [Mode Irit Kuota tapi Kualitas Sama Beningnya]": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/models.DownloadLink"
-                        }
-                    }
+                "message": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
                 }
             }
         },
-        "models.EpisodeDetailResponse": {
+        "models.HomeResponse": {
             "type": "object",
             "properties": {
-                "anime_info": {
-                    "$ref": "#/definitions/models.AnimeInfo"
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
                 },
                 "confidence_score": {
                     "type": "number"
                 },
-                "download_links": {
-                    "$ref": "#/definitions/models.DownloadLinksGroup"
+                "jadwal_rilis": {
+                    "$ref": "#/definitions/models.ScheduleData"
                 },
                 "message": {
                     "type": "string"
                 },
-                "navigation": {
-                    "$ref": "#/definitions/models.EpisodeNavigation"
-                },
-                "other_episodes": {
+                "movies": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.OtherEpisode"
+                        "$ref": "#/definitions/models.MovieItem"
                     }
                 },
-                "release_info": {
-                    "type": "string"
+                "new_eps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewEpisodeItem"
+                    }
                 },
                 "source": {
                     "type": "string"
                 },
-                "streaming_servers": {
+                "top10": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Top10Item"
+                    }
+                }
+            }
+        },
+        "models.HomeSection": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.StreamingServer"
+                        "$ref": "#/definitions/models.HomeSectionItem"
                     }
                 },
-                "thumbnail_url": {
+                "more_link": {
                     "type": "string"
                 },
                 "title": {
@@ -623,86 +2648,76 @@ const docTemplate = `{
                 }
             }
         },
-        "models.EpisodeListItem": {
+        "models.HomeSectionItem": {
             "type": "object",
             "properties": {
-                "episode": {
+                "anime_slug": {
                     "type": "string"
                 },
-                "episode_slug": {
+                "cover": {
                     "type": "string"
                 },
-                "release_date": {
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "episode": {
                     "type": "string"
                 },
-                "title": {
+                "episode_number": {
+                    "type": "number"
+                },
+                "judul": {
                     "type": "string"
                 },
-                "url": {
+                "quality": {
                     "type": "string"
-                }
-            }
-        },
-        "models.EpisodeNavigation": {
-            "type": "object",
-            "properties": {
-                "all_episodes_url": {
+                },
+                "rank": {
                     "type": "string"
                 },
-                "next_episode_url": {
+                "rating": {
                     "type": "string"
                 },
-                "previous_episode_url": {
+                "rilis": {
                     "type": "string"
-                }
-            }
-        },
-        "models.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "confidence_score": {
+                },
+                "score_value": {
                     "type": "number"
                 },
-                "error": {
-                    "type": "boolean"
+                "url": {
+                    "type": "string"
                 },
-                "message": {
+                "views": {
                     "type": "string"
+                },
+                "views_count": {
+                    "type": "integer"
                 }
             }
         },
-        "models.HomeResponse": {
+        "models.HomeSectionsResponse": {
             "type": "object",
             "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
-                "jadwal_rilis": {
-                    "$ref": "#/definitions/models.ScheduleData"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "movies": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.MovieItem"
+                        "$ref": "#/definitions/models.HomeSection"
                     }
                 },
-                "new_eps": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.NewEpisodeItem"
-                    }
+                "message": {
+                    "type": "string"
                 },
                 "source": {
                     "type": "string"
-                },
-                "top10": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Top10Item"
-                    }
                 }
             }
         },
@@ -715,6 +2730,12 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "genres": {
                     "type": "array",
                     "items": {
@@ -724,9 +2745,24 @@ const docTemplate = `{
                 "judul": {
                     "type": "string"
                 },
+                "released_at": {
+                    "type": "string"
+                },
+                "score_value": {
+                    "type": "number"
+                },
                 "sinopsis": {
                     "type": "string"
                 },
+                "sinopsis_markdown": {
+                    "type": "string"
+                },
+                "sinopsis_paragraphs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "skor": {
                     "type": "string"
                 },
@@ -741,6 +2777,9 @@ const docTemplate = `{
                 },
                 "views": {
                     "type": "string"
+                },
+                "views_count": {
+                    "type": "integer"
                 }
             }
         },
@@ -753,6 +2792,12 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "genres": {
                     "type": "array",
                     "items": {
@@ -762,6 +2807,9 @@ const docTemplate = `{
                 "judul": {
                     "type": "string"
                 },
+                "released_at": {
+                    "type": "string"
+                },
                 "tanggal": {
                     "type": "string"
                 },
@@ -773,6 +2821,9 @@ const docTemplate = `{
         "models.MovieResponse": {
             "type": "object",
             "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
@@ -785,6 +2836,9 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
                 "source": {
                     "type": "string"
                 }
@@ -799,12 +2853,24 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "episode": {
                     "type": "string"
                 },
+                "episode_number": {
+                    "type": "number"
+                },
                 "judul": {
                     "type": "string"
                 },
+                "released_at": {
+                    "type": "string"
+                },
                 "rilis": {
                     "type": "string"
                 },
@@ -816,6 +2882,12 @@ const docTemplate = `{
         "models.OtherEpisode": {
             "type": "object",
             "properties": {
+                "episode_number": {
+                    "type": "number"
+                },
+                "is_current": {
+                    "type": "boolean"
+                },
                 "release_date": {
                     "type": "string"
                 },
@@ -830,6 +2902,55 @@ const docTemplate = `{
                 }
             }
         },
+        "models.PageMeta": {
+            "type": "object",
+            "properties": {
+                "canonical_changed": {
+                    "type": "boolean"
+                },
+                "canonical_url": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "og_description": {
+                    "type": "string"
+                },
+                "og_image": {
+                    "type": "string"
+                },
+                "og_title": {
+                    "type": "string"
+                },
+                "og_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "twitter_card": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Pagination": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "last_page": {
+                    "type": "integer"
+                },
+                "next_page_url": {
+                    "type": "string"
+                }
+            }
+        },
         "models.RecommendationItem": {
             "type": "object",
             "properties": {
@@ -839,12 +2960,21 @@ const docTemplate = `{
                 "cover_url": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "episode": {
                     "type": "string"
                 },
                 "rating": {
                     "type": "string"
                 },
+                "score_value": {
+                    "type": "number"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -909,6 +3039,12 @@ const docTemplate = `{
                 "cover_url": {
                     "type": "string"
                 },
+                "episode": {
+                    "type": "string"
+                },
+                "episode_number": {
+                    "type": "number"
+                },
                 "genres": {
                     "type": "array",
                     "items": {
@@ -918,6 +3054,9 @@ const docTemplate = `{
                 "release_time": {
                     "type": "string"
                 },
+                "release_time_known": {
+                    "type": "boolean"
+                },
                 "score": {
                     "type": "string"
                 },
@@ -935,6 +3074,9 @@ const docTemplate = `{
         "models.ScheduleResponse": {
             "type": "object",
             "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
@@ -952,6 +3094,9 @@ const docTemplate = `{
         "models.SearchResponse": {
             "type": "object",
             "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
                 "confidence_score": {
                     "type": "number"
                 },
@@ -964,6 +3109,12 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
+                "pages_fetched": {
+                    "type": "integer"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
                 "source": {
                     "type": "string"
                 }
@@ -978,6 +3129,12 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "genre": {
                     "type": "array",
                     "items": {
@@ -990,6 +3147,9 @@ const docTemplate = `{
                 "penonton": {
                     "type": "string"
                 },
+                "score_value": {
+                    "type": "number"
+                },
                 "sinopsis": {
                     "type": "string"
                 },
@@ -999,11 +3159,107 @@ const docTemplate = `{
                 "status": {
                     "type": "string"
                 },
+                "status_source": {
+                    "type": "string"
+                },
                 "tipe": {
                     "type": "string"
                 },
+                "type_source": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "views_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SeasonGroup": {
+            "type": "object",
+            "properties": {
+                "episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EpisodeListItem"
+                    }
+                },
+                "season_number": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SeriesListItem": {
+            "type": "object",
+            "properties": {
+                "anime_slug": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "episode": {
+                    "type": "string"
+                },
+                "episode_number": {
+                    "type": "number"
+                },
+                "judul": {
+                    "type": "string"
+                },
+                "rilis": {
+                    "type": "string"
+                },
+                "score_value": {
+                    "type": "number"
+                },
+                "skor": {
+                    "type": "string"
+                },
                 "url": {
                     "type": "string"
+                },
+                "views": {
+                    "type": "string"
+                },
+                "views_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SeriesListResponse": {
+            "type": "object",
+            "properties": {
+                "confidence_breakdown": {
+                    "$ref": "#/definitions/models.ConfidenceBreakdown"
+                },
+                "confidence_score": {
+                    "type": "number"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SeriesListItem"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                },
+                "source": {
+                    "type": "string"
                 }
             }
         },
@@ -1027,6 +3283,12 @@ const docTemplate = `{
                 "cover": {
                     "type": "string"
                 },
+                "defaulted_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "genres": {
                     "type": "array",
                     "items": {
@@ -1039,6 +3301,9 @@ const docTemplate = `{
                 "rating": {
                     "type": "string"
                 },
+                "score_value": {
+                    "type": "number"
+                },
                 "url": {
                     "type": "string"
                 }
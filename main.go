@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	v1 "github.com/nabilulilalbab/winbu.tv/api/v1"
+	v2 "github.com/nabilulilalbab/winbu.tv/api/v2"
 	"github.com/nabilulilalbab/winbu.tv/config"
 	"github.com/nabilulilalbab/winbu.tv/dashboard"
 	"github.com/nabilulilalbab/winbu.tv/database"
@@ -114,6 +115,10 @@ func main() {
 	v1Group := r.Group("/api/v1")
 	v1.SetupRoutes(v1Group, dynamicConfig)
 
+	// API v2 routes: same endpoints, null instead of placeholder values
+	v2Group := r.Group("/api/v2")
+	v2.SetupRoutes(v2Group, dynamicConfig)
+
 	// Dashboard/Admin API routes
 	apiGroup := r.Group("/api")
 	dashboard.SetupRoutes(apiGroup, dynamicConfig)
@@ -2,9 +2,40 @@ package models
 
 // Base response structure with confidence score
 type BaseResponse struct {
-	ConfidenceScore float64 `json:"confidence_score"`
-	Message         string  `json:"message,omitempty"`
-	Source          string  `json:"source,omitempty"`
+	ConfidenceScore     float64              `json:"confidence_score"`
+	ConfidenceBreakdown *ConfidenceBreakdown `json:"confidence_breakdown,omitempty"`
+	Message             string               `json:"message,omitempty"`
+	Source              string               `json:"source,omitempty"`
+}
+
+// ConfidenceBreakdown explains a confidence score. FieldScore is the
+// weighted share of valid field values; the confidence score is FieldScore
+// times ErrorPenalty.
+type ConfidenceBreakdown struct {
+	FieldScore   float64           `json:"field_score"`
+	ErrorCount   int               `json:"error_count"`
+	ErrorPenalty float64           `json:"error_penalty"`
+	Fields       []FieldConfidence `json:"fields"`
+}
+
+// FieldConfidence explains how one field contributed to a confidence score.
+// Issues counts the values that failed validation by reason, e.g.
+// {"missing": 2, "placeholder": 1}.
+type FieldConfidence struct {
+	Field   string         `json:"field"`
+	Weight  float64        `json:"weight"`
+	Checked int            `json:"checked"`
+	Valid   int            `json:"valid"`
+	Score   float64        `json:"score"`
+	Issues  map[string]int `json:"issues,omitempty"`
+}
+
+// Pagination describes where a paginated list response sits in the listing
+type Pagination struct {
+	CurrentPage int    `json:"current_page"`
+	LastPage    int    `json:"last_page"`
+	HasNext     bool   `json:"has_next"`
+	NextPageURL string `json:"next_page_url,omitempty"`
 }
 
 type ErrorResponse struct {
@@ -13,43 +44,58 @@ type ErrorResponse struct {
 	ConfidenceScore float64 `json:"confidence_score"`
 }
 
-// Home page response models
+// Home page response models. Fields the site may leave blank are null in
+// null mode; otherwise they hold a placeholder listed in DefaultedFields.
+// ReleasedAt is the raw upload date parsed into RFC3339 (WIB), or null when
+// it could not be parsed. ScoreValue, ViewsCount and EpisodeNumber are the
+// numbers parsed from the raw score, views and episode text, and are null
+// when the text holds no number or only a placeholder.
 type Top10Item struct {
-	Judul     string   `json:"judul"`
-	URL       string   `json:"url"`
-	AnimeSlug string   `json:"anime_slug"`
-	Rating    string   `json:"rating"`
-	Cover     string   `json:"cover"`
-	Genres    []string `json:"genres"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Rating          *string  `json:"rating"`
+	ScoreValue      *float64 `json:"score_value"`
+	Cover           string   `json:"cover"`
+	Genres          []string `json:"genres"`
+	DefaultedFields []string `json:"defaulted_fields"`
 }
 
 type NewEpisodeItem struct {
-	Judul     string `json:"judul"`
-	URL       string `json:"url"`
-	AnimeSlug string `json:"anime_slug"`
-	Episode   string `json:"episode"`
-	Rilis     string `json:"rilis"`
-	Cover     string `json:"cover"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Episode         *string  `json:"episode"`
+	EpisodeNumber   *float64 `json:"episode_number"`
+	Rilis           *string  `json:"rilis"`
+	ReleasedAt      *string  `json:"released_at"`
+	Cover           string   `json:"cover"`
+	DefaultedFields []string `json:"defaulted_fields"`
 }
 
 type MovieItem struct {
-	Judul     string   `json:"judul"`
-	URL       string   `json:"url"`
-	AnimeSlug string   `json:"anime_slug"`
-	Tanggal   string   `json:"tanggal"`
-	Cover     string   `json:"cover"`
-	Genres    []string `json:"genres"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Tanggal         *string  `json:"tanggal"`
+	ReleasedAt      *string  `json:"released_at"`
+	Cover           string   `json:"cover"`
+	Genres          []string `json:"genres"`
+	DefaultedFields []string `json:"defaulted_fields"`
 }
 
 type ScheduleItem struct {
-	Title       string   `json:"title"`
-	URL         string   `json:"url"`
-	AnimeSlug   string   `json:"anime_slug"`
-	CoverURL    string   `json:"cover_url"`
-	Type        string   `json:"type"`
-	Score       string   `json:"score"`
-	Genres      []string `json:"genres"`
-	ReleaseTime string   `json:"release_time"`
+	Title            string   `json:"title"`
+	URL              string   `json:"url"`
+	AnimeSlug        string   `json:"anime_slug"`
+	CoverURL         string   `json:"cover_url"`
+	Type             string   `json:"type"`
+	Score            string   `json:"score"`
+	Genres           []string `json:"genres"`
+	Episode          string   `json:"episode,omitempty"`
+	EpisodeNumber    *float64 `json:"episode_number,omitempty"`
+	ReleaseTime      string   `json:"release_time"`
+	ReleaseTimeKnown bool     `json:"release_time_known"`
 }
 
 type ScheduleData struct {
@@ -70,6 +116,39 @@ type HomeResponse struct {
 	JadwalRilis ScheduleData     `json:"jadwal_rilis"`
 }
 
+// Generic homepage section models for /api/v1/home/sections. Sections carry
+// different fields, so rating, episode, rilis and views are omitted when the
+// item's section does not show them. An item left blank where the rest of its
+// section shows the field is omitted in null mode and holds a placeholder
+// listed in DefaultedFields otherwise.
+type HomeSectionItem struct {
+	Rank            string   `json:"rank,omitempty"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Cover           string   `json:"cover"`
+	Rating          *string  `json:"rating,omitempty"`
+	ScoreValue      *float64 `json:"score_value,omitempty"`
+	Episode         *string  `json:"episode,omitempty"`
+	EpisodeNumber   *float64 `json:"episode_number,omitempty"`
+	Rilis           *string  `json:"rilis,omitempty"`
+	Views           *string  `json:"views,omitempty"`
+	ViewsCount      *int64   `json:"views_count,omitempty"`
+	Quality         string   `json:"quality,omitempty"`
+	DefaultedFields []string `json:"defaulted_fields"`
+}
+
+type HomeSection struct {
+	Title    string            `json:"title"`
+	MoreLink string            `json:"more_link,omitempty"`
+	Items    []HomeSectionItem `json:"items"`
+}
+
+type HomeSectionsResponse struct {
+	BaseResponse
+	Data []HomeSection `json:"data"`
+}
+
 // Schedule response for /api/v1/jadwal-rilis endpoint
 type ScheduleResponse struct {
 	BaseResponse
@@ -78,37 +157,93 @@ type ScheduleResponse struct {
 
 // Anime terbaru response models
 type AnimeTerbaruItem struct {
-	Judul     string `json:"judul"`
-	URL       string `json:"url"`
-	AnimeSlug string `json:"anime_slug"`
-	Episode   string `json:"episode"`
-	Uploader  string `json:"uploader"`
-	Rilis     string `json:"rilis"`
-	Cover     string `json:"cover"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Episode         *string  `json:"episode"`
+	EpisodeNumber   *float64 `json:"episode_number"`
+	Uploader        *string  `json:"uploader"`
+	Rilis           *string  `json:"rilis"`
+	ReleasedAt      *string  `json:"released_at"`
+	Cover           string   `json:"cover"`
+	DefaultedFields []string `json:"defaulted_fields"`
 }
 
 type AnimeTerbaruResponse struct {
 	BaseResponse
-	Data []AnimeTerbaruItem `json:"data"`
+	Pagination Pagination         `json:"pagination"`
+	Data       []AnimeTerbaruItem `json:"data"`
 }
 
 // Movie response models
 type MovieDetailItem struct {
-	Judul     string   `json:"judul"`
-	URL       string   `json:"url"`
-	AnimeSlug string   `json:"anime_slug"`
-	Status    string   `json:"status"`
-	Skor      string   `json:"skor"`
-	Sinopsis  string   `json:"sinopsis"`
-	Views     string   `json:"views"`
-	Cover     string   `json:"cover"`
-	Genres    []string `json:"genres"`
-	Tanggal   string   `json:"tanggal"`
+	Judul              string   `json:"judul"`
+	URL                string   `json:"url"`
+	AnimeSlug          string   `json:"anime_slug"`
+	Status             string   `json:"status"`
+	Skor               *string  `json:"skor"`
+	ScoreValue         *float64 `json:"score_value"`
+	Sinopsis           *string  `json:"sinopsis"`
+	SinopsisParagraphs []string `json:"sinopsis_paragraphs"`
+	SinopsisMarkdown   *string  `json:"sinopsis_markdown"`
+	Views              *string  `json:"views"`
+	ViewsCount         *int64   `json:"views_count"`
+	Cover              string   `json:"cover"`
+	Genres             []string `json:"genres"`
+	Tanggal            *string  `json:"tanggal"`
+	ReleasedAt         *string  `json:"released_at"`
+	DefaultedFields    []string `json:"defaulted_fields"`
 }
 
 type MovieResponse struct {
 	BaseResponse
-	Data []MovieDetailItem `json:"data"`
+	Pagination Pagination        `json:"pagination"`
+	Data       []MovieDetailItem `json:"data"`
+}
+
+// Series listing response models (TV Show, live-action drama, genre). Blank
+// episode, rilis, views and skor are null in null mode; otherwise they hold a
+// placeholder listed in DefaultedFields.
+type SeriesListItem struct {
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Episode         *string  `json:"episode"`
+	EpisodeNumber   *float64 `json:"episode_number"`
+	Rilis           *string  `json:"rilis"`
+	Views           *string  `json:"views"`
+	ViewsCount      *int64   `json:"views_count"`
+	Skor            *string  `json:"skor"`
+	ScoreValue      *float64 `json:"score_value"`
+	Cover           string   `json:"cover"`
+	DefaultedFields []string `json:"defaulted_fields"`
+}
+
+type SeriesListResponse struct {
+	BaseResponse
+	Pagination Pagination       `json:"pagination"`
+	Data       []SeriesListItem `json:"data"`
+}
+
+// Genre represents a genre from the genre index
+type Genre struct {
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	URL   string `json:"url"`
+	Count int    `json:"count"`
+}
+
+type GenresResponse struct {
+	BaseResponse
+	Data []Genre `json:"data"`
+}
+
+// GenreListResponse represents the titles listed under a single genre
+type GenreListResponse struct {
+	BaseResponse
+	Genre      string           `json:"genre"`
+	Pagination Pagination       `json:"pagination"`
+	Data       []SeriesListItem `json:"data"`
 }
 
 // Single day schedule response
@@ -119,80 +254,213 @@ type DayScheduleResponse struct {
 
 // Search response models
 type SearchResultItem struct {
-	Judul     string   `json:"judul"`
-	URL       string   `json:"url"`
-	AnimeSlug string   `json:"anime_slug"`
-	Status    string   `json:"status"`
-	Tipe      string   `json:"tipe"`
-	Skor      string   `json:"skor"`
-	Penonton  string   `json:"penonton"`
-	Sinopsis  string   `json:"sinopsis"`
-	Genre     []string `json:"genre"`
-	Cover     string   `json:"cover"`
+	Judul           string   `json:"judul"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	Status          string   `json:"status"`
+	StatusSource    string   `json:"status_source"`
+	Tipe            string   `json:"tipe"`
+	TypeSource      string   `json:"type_source"`
+	Skor            *string  `json:"skor"`
+	ScoreValue      *float64 `json:"score_value"`
+	Penonton        *string  `json:"penonton"`
+	ViewsCount      *int64   `json:"views_count"`
+	Sinopsis        *string  `json:"sinopsis"`
+	Genre           []string `json:"genre"`
+	Cover           string   `json:"cover"`
+	DefaultedFields []string `json:"defaulted_fields"`
 }
 
 type SearchResponse struct {
 	BaseResponse
-	Data []SearchResultItem `json:"data"`
+	Pagination   Pagination         `json:"pagination"`
+	PagesFetched int                `json:"pages_fetched,omitempty"`
+	Data         []SearchResultItem `json:"data"`
+}
+
+// CatalogFilter holds the daftar-anime-2 filter parameters
+type CatalogFilter struct {
+	Title  string   `json:"title,omitempty"`
+	Status string   `json:"status,omitempty"`
+	Type   string   `json:"type,omitempty"`
+	Order  string   `json:"order,omitempty"`
+	Genres []string `json:"genres,omitempty"`
+	Page   int      `json:"page"`
+}
+
+// CatalogResponse represents the response for catalog browse endpoint
+type CatalogResponse struct {
+	BaseResponse
+	Filters    CatalogFilter      `json:"filters"`
+	Pagination Pagination         `json:"pagination"`
+	Data       []SearchResultItem `json:"data"`
+}
+
+// AZLetterCount represents the number of titles under one A–Z letter
+type AZLetterCount struct {
+	Letter string `json:"letter"`
+	Count  int    `json:"count"`
+}
+
+// AZSummaryResponse represents the per-letter counts of the A–Z index
+type AZSummaryResponse struct {
+	BaseResponse
+	Complete bool            `json:"complete"`
+	Total    int             `json:"total"`
+	Data     []AZLetterCount `json:"data"`
+}
+
+// AZLetterResponse represents the titles listed under one A–Z letter
+type AZLetterResponse struct {
+	BaseResponse
+	Letter     string             `json:"letter"`
+	Pagination Pagination         `json:"pagination"`
+	Data       []SearchResultItem `json:"data"`
+}
+
+// FilterOption represents a single filter choice (e.g. DisplayName "Popular", QueryValue "popular")
+type FilterOption struct {
+	DisplayName string `json:"display_name"`
+	QueryValue  string `json:"query_value"`
+}
+
+// AvailableFilters holds every filter option offered by daftar-anime-2
+type AvailableFilters struct {
+	StatusOptions []FilterOption `json:"status_options"`
+	TypeOptions   []FilterOption `json:"type_options"`
+	OrderOptions  []FilterOption `json:"order_options"`
+	GenreOptions  []FilterOption `json:"genre_options"`
+}
+
+// FiltersResponse represents the response for filter options endpoint
+type FiltersResponse struct {
+	BaseResponse
+	Data AvailableFilters `json:"data"`
+}
+
+// PageMeta holds the SEO/OpenGraph metadata of a scraped page
+type PageMeta struct {
+	Title            string `json:"title"`
+	Description      string `json:"description"`
+	CanonicalURL     string `json:"canonical_url"`
+	CanonicalChanged bool   `json:"canonical_changed"`
+	OgTitle          string `json:"og_title,omitempty"`
+	OgDescription    string `json:"og_description,omitempty"`
+	OgURL            string `json:"og_url,omitempty"`
+	OgImage          string `json:"og_image"`
+	TwitterCard      string `json:"twitter_card,omitempty"`
 }
 
 // AnimeDetailResponse represents the response for anime detail endpoint
 type AnimeDetailResponse struct {
 	BaseResponse
-	Judul           string               `json:"judul"`
-	URL             string               `json:"url"`
-	AnimeSlug       string               `json:"anime_slug"`
-	Cover           string               `json:"cover"`
-	EpisodeList     []EpisodeListItem    `json:"episode_list"`
-	Recommendations []RecommendationItem `json:"recommendations"`
-	Status          string               `json:"status"`
-	Tipe            string               `json:"tipe"`
-	Skor            string               `json:"skor"`
-	Penonton        string               `json:"penonton"`
-	Sinopsis        string               `json:"sinopsis"`
-	Genre           []string             `json:"genre"`
-	Details         AnimeDetails         `json:"details"`
-	Rating          AnimeRating          `json:"rating"`
-}
-
-// EpisodeListItem represents an episode in the anime detail
+	Judul              string               `json:"judul"`
+	URL                string               `json:"url"`
+	AnimeSlug          string               `json:"anime_slug"`
+	Cover              string               `json:"cover"`
+	EpisodeList        []EpisodeListItem    `json:"episode_list"`
+	Seasons            []SeasonGroup        `json:"seasons,omitempty"`
+	Recommendations    []RecommendationItem `json:"recommendations"`
+	Status             string               `json:"status"`
+	StatusSource       string               `json:"status_source"`
+	Tipe               string               `json:"tipe"`
+	TypeSource         string               `json:"type_source"`
+	Skor               *string              `json:"skor"`
+	ScoreValue         *float64             `json:"score_value"`
+	Penonton           *string              `json:"penonton"`
+	ViewsCount         *int64               `json:"views_count"`
+	Sinopsis           string               `json:"sinopsis"`
+	SinopsisParagraphs []string             `json:"sinopsis_paragraphs"`
+	SinopsisMarkdown   string               `json:"sinopsis_markdown"`
+	Genre              []string             `json:"genre"`
+	Details            AnimeDetails         `json:"details"`
+	Rating             AnimeRating          `json:"rating"`
+	PageMeta           PageMeta             `json:"page_meta"`
+	DefaultedFields    []string             `json:"defaulted_fields"`
+}
+
+// EpisodeListItem represents an episode in the anime detail. EpisodeNumber
+// and ReleaseDate are null when the page does not show them; ReleasedAt is
+// ReleaseDate in RFC3339.
 type EpisodeListItem struct {
-	Episode     string `json:"episode"`
-	Title       string `json:"title"`
-	URL         string `json:"url"`
-	EpisodeSlug string `json:"episode_slug"`
-	ReleaseDate string `json:"release_date"`
+	Episode       string   `json:"episode"`
+	EpisodeNumber *float64 `json:"episode_number"`
+	EpisodeType   string   `json:"episode_type"`
+	Title         string   `json:"title"`
+	URL           string   `json:"url"`
+	EpisodeSlug   string   `json:"episode_slug"`
+	ReleaseDate   *string  `json:"release_date"`
+	ReleasedAt    *string  `json:"released_at"`
+}
+
+// SeasonGroup represents one season block of a series that groups its
+// episodes by season
+type SeasonGroup struct {
+	Title        string            `json:"title"`
+	SeasonNumber *int              `json:"season_number"`
+	Episodes     []EpisodeListItem `json:"episodes"`
+}
+
+// How a franchise entry was found
+const (
+	FranchiseFoundViaSelf           = "self"
+	FranchiseFoundViaRecommendation = "recommendation"
+	FranchiseFoundViaSearch         = "search"
+)
+
+// FranchiseEntry represents one season, movie or special of a franchise
+type FranchiseEntry struct {
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	AnimeSlug    string `json:"anime_slug"`
+	Cover        string `json:"cover"`
+	SeasonNumber *int   `json:"season_number"`
+	IsCurrent    bool   `json:"is_current"`
+	FoundVia     string `json:"found_via"`
 }
 
-// RecommendationItem represents a recommended anime
-type RecommendationItem struct {
-	Title     string `json:"title"`
-	URL       string `json:"url"`
-	AnimeSlug string `json:"anime_slug"`
-	CoverURL  string `json:"cover_url"`
-	Rating    string `json:"rating"`
-	Episode   string `json:"episode"`
+// FranchiseResponse represents the response for the franchise endpoint
+type FranchiseResponse struct {
+	BaseResponse
+	AnimeSlug string           `json:"anime_slug"`
+	BaseTitle string           `json:"base_title"`
+	Data      []FranchiseEntry `json:"data"`
 }
 
-// AnimeDetails represents detailed information about an anime
+// RecommendationItem represents a recommended anime. Recommendation cards do
+// not show an episode, so Episode is null unless filled with a placeholder.
+type RecommendationItem struct {
+	Title           string   `json:"title"`
+	URL             string   `json:"url"`
+	AnimeSlug       string   `json:"anime_slug"`
+	CoverURL        string   `json:"cover_url"`
+	Rating          string   `json:"rating"`
+	ScoreValue      *float64 `json:"score_value"`
+	Episode         *string  `json:"episode"`
+	DefaultedFields []string `json:"defaulted_fields"`
+}
+
+// AnimeDetails represents detailed information about an anime. Fields the
+// detail page does not show are null.
 type AnimeDetails struct {
-	Japanese     string `json:"Japanese"`
-	English      string `json:"English"`
-	Status       string `json:"Status"`
-	Type         string `json:"Type"`
-	Source       string `json:"Source"`
-	Duration     string `json:"Duration"`
-	TotalEpisode string `json:"Total Episode"`
-	Season       string `json:"Season"`
-	Studio       string `json:"Studio"`
-	Producers    string `json:"Producers"`
-	Released     string `json:"Released:"`
-}
-
-// AnimeRating represents rating information
+	Japanese     *string `json:"Japanese"`
+	English      *string `json:"English"`
+	Status       *string `json:"Status"`
+	Type         *string `json:"Type"`
+	Source       *string `json:"Source"`
+	Duration     *string `json:"Duration"`
+	TotalEpisode *string `json:"Total Episode"`
+	Season       *string `json:"Season"`
+	Studio       *string `json:"Studio"`
+	Producers    *string `json:"Producers"`
+	Released     *string `json:"Released:"`
+}
+
+// AnimeRating represents rating information. The site does not show the
+// number of users, so Users is null unless filled with a placeholder.
 type AnimeRating struct {
-	Score string `json:"score"`
-	Users string `json:"users"`
+	Score *string `json:"score"`
+	Users *string `json:"users"`
 }
 
 // EpisodeDetailResponse represents the response for episode detail endpoint
@@ -201,11 +469,13 @@ type EpisodeDetailResponse struct {
 	Title            string             `json:"title"`
 	ThumbnailURL     string             `json:"thumbnail_url"`
 	StreamingServers []StreamingServer  `json:"streaming_servers"`
-	ReleaseInfo      string             `json:"release_info"`
+	ReleaseInfo      *string            `json:"release_info"`
 	DownloadLinks    DownloadLinksGroup `json:"download_links"`
 	Navigation       EpisodeNavigation  `json:"navigation"`
 	AnimeInfo        AnimeInfo          `json:"anime_info"`
 	OtherEpisodes    []OtherEpisode     `json:"other_episodes"`
+	PageMeta         PageMeta           `json:"page_meta"`
+	DefaultedFields  []string           `json:"defaulted_fields"`
 }
 
 // EpisodeNavigation represents navigation between episodes
@@ -262,16 +532,23 @@ type DownloadLinksGroup struct {
 
 // AnimeInfo represents information about the anime series
 type AnimeInfo struct {
-	Title        string   `json:"title"`
-	ThumbnailURL string   `json:"thumbnail_url"`
-	Synopsis     string   `json:"synopsis"`
-	Genres       []string `json:"genres"`
-}
-
-// OtherEpisode represents other episodes from the same series
+	Title              *string  `json:"title"`
+	ThumbnailURL       string   `json:"thumbnail_url"`
+	Synopsis           *string  `json:"synopsis"`
+	SynopsisParagraphs []string `json:"synopsis_paragraphs"`
+	SynopsisMarkdown   *string  `json:"synopsis_markdown"`
+	Genres             []string `json:"genres"`
+}
+
+// OtherEpisode represents other episodes from the same series. The episode
+// picker usually shows neither images nor dates, so ThumbnailURL and
+// ReleaseDate are null unless the picker lists them; the current episode
+// takes its date from the page's upload time.
 type OtherEpisode struct {
-	Title        string `json:"title"`
-	URL          string `json:"url"`
-	ThumbnailURL string `json:"thumbnail_url"`
-	ReleaseDate  string `json:"release_date"`
+	Title         string   `json:"title"`
+	URL           string   `json:"url"`
+	EpisodeNumber *float64 `json:"episode_number"`
+	IsCurrent     bool     `json:"is_current"`
+	ThumbnailURL  *string  `json:"thumbnail_url"`
+	ReleaseDate   *string  `json:"release_date"`
 }
@@ -3,6 +3,7 @@ package scrapers
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gocolly/colly/v2"
 	"github.com/nabilulilalbab/winbu.tv/config"
@@ -32,29 +33,21 @@ func (a *AnimeScraper) ScrapeAnimeTerbaru(page int) (*models.AnimeTerbaruRespons
 	}
 
 	var scrapingErrors []string
+	pager := newPageTracker(c, page)
 
 	// Scrape anime items
 	c.OnHTML("div.ml-item.ml-item-anime.ml-item-latest", func(e *colly.HTMLElement) {
 		item := models.AnimeTerbaruItem{
-			Judul:     utils.CleanText(e.ChildText(".judul")),
-			URL:       e.ChildAttr("a.ml-mask", "href"),
-			AnimeSlug: utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
-			Episode:   utils.CleanText(e.ChildText(".mli-episode")),
-			Uploader:  utils.CleanText(e.ChildText(".mli-uploader")), // Might need adjustment based on actual HTML
-			Rilis:     utils.CleanText(e.ChildText(".mli-waktu")),
-			Cover:     e.ChildAttr("img.mli-thumb", "src"),
-		}
-
-		// Fill missing fields with dummy data
-		if item.Uploader == "" {
-			item.Uploader = "WinbuTV Admin"
-		}
-		if item.Episode == "" {
-			item.Episode = "Episode 1"
-		}
-		if item.Rilis == "" {
-			item.Rilis = "January 2025"
+			Judul:      utils.CleanText(e.ChildText(".judul")),
+			URL:        e.ChildAttr("a.ml-mask", "href"),
+			AnimeSlug:  utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
+			Episode:    optionalString(e.ChildText(".mli-episode")),
+			Uploader:   optionalString(e.ChildText(".mli-uploader")), // Might need adjustment based on actual HTML
+			Rilis:      optionalString(e.ChildText(".mli-waktu")),
+			ReleasedAt: utils.FormatRFC3339(e.ChildText(".mli-waktu"), time.Now()),
+			Cover:      imageURL(a.config, e, "img.mli-thumb"),
 		}
+		item.EpisodeNumber = utils.EpisodeNumberValue(stringValue(item.Episode))
 
 		response.Data = append(response.Data, item)
 	})
@@ -71,52 +64,45 @@ func (a *AnimeScraper) ScrapeAnimeTerbaru(page int) (*models.AnimeTerbaruRespons
 	}
 
 	// Visit the page
-	if err := c.Visit(url); err != nil {
+	if err := c.Visit(url); err != nil && !pager.notFound {
 		return nil, fmt.Errorf("failed to visit anime terbaru page: %v", err)
 	}
 
-	// Calculate confidence score
-	totalFields := len(response.Data) * 7 // 7 fields per item
-	filledFields := 0
-
-	for _, item := range response.Data {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Episode != "" {
-			filledFields++
-		}
-		if item.Uploader != "" {
-			filledFields++
-		}
-		if item.Rilis != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
+	pagination, err := pager.result(len(response.Data))
+	if err != nil {
+		return nil, err
 	}
+	response.Pagination = pagination
 
-	if totalFields > 0 {
-		response.ConfidenceScore = float64(filledFields) / float64(totalFields)
-	} else {
-		response.ConfidenceScore = 0.0
-	}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = animeTerbaruConfidence(response.Data, scrapingErrors)
 
-	// Adjust confidence score based on errors
-	if len(scrapingErrors) > 0 {
-		response.ConfidenceScore *= 0.8
-		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
-	} else {
-		response.Message = "Data berhasil diambil"
-	}
+	fillAnimeTerbaruPlaceholders(a.config, response.Data)
 
 	return response, nil
 }
 
+// animeTerbaruConfidence calculates the confidence score, message and
+// breakdown for a list of AnimeTerbaruItem entries.
+func animeTerbaruConfidence(items []models.AnimeTerbaruItem, scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("episode", 2, utils.ValidateText).
+		Field("rilis", 2, utils.ValidateText).
+		Field("uploader", 0.5, utils.ValidateText)
+
+	for _, item := range items {
+		scorer.Item(item.DefaultedFields)
+		scorer.Check("judul", item.Judul)
+		scorer.Check("url", item.URL)
+		scorer.Check("anime_slug", item.AnimeSlug)
+		scorer.Check("cover", item.Cover)
+		scorer.CheckOptional("episode", item.Episode)
+		scorer.CheckOptional("rilis", item.Rilis)
+		scorer.CheckOptional("uploader", item.Uploader)
+	}
+
+	return scorer.Result(scrapingErrors)
+}
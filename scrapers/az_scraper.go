@@ -0,0 +1,324 @@
+package scrapers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+const (
+	// azCacheTTL is how long the A–Z index is cached. Building it walks the
+	// whole alphabetical catalog, so it is only rebuilt every few hours.
+	azCacheTTL = 6 * 60 * 60
+	// azPartialCacheTTL is how long an index missing catalog pages is cached
+	// before it is rebuilt
+	azPartialCacheTTL = 10 * 60
+	// maxAZPages bounds how many catalog pages one index build walks
+	maxAZPages = 150
+	// azPageSize is the number of titles per page of /az/:letter
+	azPageSize = 30
+	// azOtherLetter groups titles that do not start with A–Z
+	azOtherLetter = "0-9"
+)
+
+// azCache is shared across scraper instances so the index survives the
+// per-request scraper construction in the API handlers.
+var azCache = utils.NewCache()
+
+// runningAZBuilds tracks the index builds running in the background by
+// cache key
+var (
+	runningAZBuildsMu sync.Mutex
+	runningAZBuilds   = map[string]*azBuildProgress{}
+)
+
+// azIndex is the alphabetical catalog grouped by first letter
+type azIndex struct {
+	Letters      map[string][]models.SearchResultItem `json:"letters"`
+	PagesScraped int                                  `json:"pages_scraped"`
+	LastPage     int                                  `json:"last_page"`
+	Errors       []string                             `json:"errors"`
+	Building     bool                                 `json:"-"`
+}
+
+// azBuildProgress is the progress of a running index build
+type azBuildProgress struct {
+	pagesScraped int64
+	lastPage     int64
+}
+
+// snapshot returns the empty index served while the build runs
+func (p *azBuildProgress) snapshot() *azIndex {
+	return &azIndex{
+		Letters:      map[string][]models.SearchResultItem{},
+		PagesScraped: int(atomic.LoadInt64(&p.pagesScraped)),
+		LastPage:     int(atomic.LoadInt64(&p.lastPage)),
+		Building:     true,
+	}
+}
+
+type AZScraper struct {
+	config *config.Config
+}
+
+func NewAZScraper(cfg *config.Config) *AZScraper {
+	return &AZScraper{config: cfg}
+}
+
+// AZLetters returns every letter of the index in display order.
+func AZLetters() []string {
+	letters := []string{azOtherLetter}
+	for r := 'A'; r <= 'Z'; r++ {
+		letters = append(letters, string(r))
+	}
+	return letters
+}
+
+// NormalizeAZLetter returns the index letter for user input such as "a",
+// "Z" or "0-9", or "" when the input is not a valid letter.
+func NormalizeAZLetter(letter string) string {
+	letter = strings.ToUpper(strings.TrimSpace(letter))
+	if letter == azOtherLetter || letter == "#" {
+		return azOtherLetter
+	}
+	if len(letter) == 1 && letter[0] >= 'A' && letter[0] <= 'Z' {
+		return letter
+	}
+	return ""
+}
+
+// ScrapeAZSummary returns the number of titles under every letter.
+func (a *AZScraper) ScrapeAZSummary() (*models.AZSummaryResponse, error) {
+	index, err := a.buildIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.AZSummaryResponse{
+		BaseResponse: models.BaseResponse{
+			Source: utils.ExtractDomain(a.config.BaseURL),
+		},
+		Complete: index.complete(),
+		Data:     []models.AZLetterCount{},
+	}
+
+	for _, letter := range AZLetters() {
+		count := len(index.Letters[letter])
+		response.Total += count
+		response.Data = append(response.Data, models.AZLetterCount{
+			Letter: letter,
+			Count:  count,
+		})
+	}
+
+	response.ConfidenceScore, response.Message = index.confidence()
+
+	return response, nil
+}
+
+// ScrapeAZLetter returns one page of the titles starting with letter.
+func (a *AZScraper) ScrapeAZLetter(letter string, page int) (*models.AZLetterResponse, error) {
+	letter = NormalizeAZLetter(letter)
+	if letter == "" {
+		return nil, fmt.Errorf("invalid letter")
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	index, err := a.buildIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	items := index.Letters[letter]
+	lastPage := (len(items) + azPageSize - 1) / azPageSize
+	if lastPage < 1 {
+		lastPage = 1
+	}
+	if page > lastPage && !index.Building {
+		return nil, fmt.Errorf("page %d: %w", page, ErrPageNotFound)
+	}
+
+	start := (page - 1) * azPageSize
+	end := start + azPageSize
+	if end > len(items) {
+		end = len(items)
+	}
+	if start > end {
+		start = end
+	}
+
+	response := &models.AZLetterResponse{
+		BaseResponse: models.BaseResponse{
+			Source: utils.ExtractDomain(a.config.BaseURL),
+		},
+		Letter: letter,
+		Pagination: models.Pagination{
+			CurrentPage: page,
+			LastPage:    lastPage,
+			HasNext:     page < lastPage,
+		},
+		Data: append([]models.SearchResultItem{}, items[start:end]...),
+	}
+
+	response.ConfidenceScore, response.Message = index.confidence()
+
+	fillSearchPlaceholders(a.config, response.Data)
+
+	return response, nil
+}
+
+// buildIndex returns the cached A–Z index. On a cold cache it checks the
+// first catalog page and then builds the index in the background, returning
+// an empty index that reports the build's progress until the build is done.
+func (a *AZScraper) buildIndex() (*azIndex, error) {
+	cacheKey := "az_index_" + utils.ExtractDomain(a.config.BaseURL)
+
+	var cached azIndex
+	if azCache.Get(cacheKey, &cached) {
+		return &cached, nil
+	}
+
+	runningAZBuildsMu.Lock()
+	if progress, ok := runningAZBuilds[cacheKey]; ok {
+		runningAZBuildsMu.Unlock()
+		return progress.snapshot(), nil
+	}
+	progress := &azBuildProgress{}
+	runningAZBuilds[cacheKey] = progress
+	runningAZBuildsMu.Unlock()
+
+	done := func() {
+		runningAZBuildsMu.Lock()
+		delete(runningAZBuilds, cacheKey)
+		runningAZBuildsMu.Unlock()
+	}
+
+	// The index is shared across API versions, so it holds only real data
+	searchScraper := NewSearchScraper(nullModeConfig(a.config))
+	order := a.alphabeticalOrder()
+	fetch := func(page int) (*models.CatalogResponse, error) {
+		result, err := searchScraper.BrowseCatalog(models.CatalogFilter{Order: order, Page: page})
+		if err == nil {
+			atomic.AddInt64(&progress.pagesScraped, 1)
+		}
+		return result, err
+	}
+
+	// Fetch the first page in the request so an unreachable site is
+	// reported instead of an index that never finishes building
+	first, err := fetch(1)
+	if err != nil {
+		done()
+		return nil, fmt.Errorf("failed to visit catalog page: %w", err)
+	}
+	atomic.StoreInt64(&progress.lastPage, int64(first.Pagination.LastPage))
+
+	go func() {
+		defer done()
+
+		// Pages that failed are retried soon; pages beyond maxAZPages would
+		// be skipped again, so a truncated index keeps the full TTL
+		index := collectAZIndex(first, fetch)
+		ttl := azCacheTTL
+		if len(index.Errors) > 0 {
+			ttl = azPartialCacheTTL
+		}
+		azCache.SetWithTTL(cacheKey, index, ttl)
+	}()
+
+	return progress.snapshot(), nil
+}
+
+// collectAZIndex walks the remaining pages of the alphabetical
+// daftar-anime-2 listing, reusing SearchScraper's item parsing, and groups
+// the titles by first letter.
+func collectAZIndex(first *models.CatalogResponse, fetch func(page int) (*models.CatalogResponse, error)) *azIndex {
+	lastPage := first.Pagination.LastPage
+	if lastPage > maxAZPages {
+		lastPage = maxAZPages
+	}
+	rest, pageErrors := fetchPagesConcurrently(2, lastPage, fetch)
+
+	index := &azIndex{
+		Letters:  map[string][]models.SearchResultItem{},
+		LastPage: first.Pagination.LastPage,
+		Errors:   pageErrors,
+	}
+	seen := make(map[string]bool)
+	for _, result := range append([]*models.CatalogResponse{first}, rest...) {
+		if result == nil {
+			continue
+		}
+		index.PagesScraped++
+		for _, item := range result.Data {
+			if seen[item.AnimeSlug] {
+				continue
+			}
+			seen[item.AnimeSlug] = true
+			letter := azLetterOf(item.Judul)
+			index.Letters[letter] = append(index.Letters[letter], item)
+		}
+	}
+
+	return index
+}
+
+// alphabeticalOrder returns the daftar-anime-2 order value for A–Z sorting,
+// discovered from the filter form and falling back to "title".
+func (a *AZScraper) alphabeticalOrder() string {
+	filters, err := NewFilterScraper(a.config).ScrapeFilters()
+	if err == nil {
+		for _, option := range filters.Data.OrderOptions {
+			name := strings.ToUpper(strings.ReplaceAll(option.DisplayName, " ", ""))
+			if name == "A-Z" || strings.EqualFold(option.QueryValue, "title") {
+				return option.QueryValue
+			}
+		}
+	}
+	return "title"
+}
+
+// azLetterOf returns the index letter a title is listed under.
+func azLetterOf(title string) string {
+	for _, r := range strings.TrimSpace(title) {
+		r = unicode.ToUpper(r)
+		if r >= 'A' && r <= 'Z' {
+			return string(r)
+		}
+		return azOtherLetter
+	}
+	return azOtherLetter
+}
+
+// complete reports whether every catalog page made it into the index.
+func (i *azIndex) complete() bool {
+	return i.PagesScraped >= i.LastPage
+}
+
+// confidence scores the index by the share of catalog pages it covers.
+// Indexes with failed pages are only cached briefly, which the message says.
+func (i *azIndex) confidence() (float64, string) {
+	if i.Building {
+		return 0.1, fmt.Sprintf("A-Z index is being built in the background (%d of %d catalog pages scraped); try again shortly", i.PagesScraped, i.LastPage)
+	}
+
+	confidence := utils.CalculateConfidenceScore(i.LastPage, i.PagesScraped)
+
+	if len(i.Errors) > 0 {
+		confidence *= 0.8
+		return confidence, fmt.Sprintf("Scraped with %d errors; index covers %d of %d catalog pages and will be rebuilt within %d minutes",
+			len(i.Errors), i.PagesScraped, i.LastPage, azPartialCacheTTL/60)
+	}
+	if !i.complete() {
+		return confidence, fmt.Sprintf("Index covers the first %d of %d catalog pages", i.PagesScraped, i.LastPage)
+	}
+	return confidence, "Data berhasil diambil"
+}
@@ -0,0 +1,46 @@
+package scrapers
+
+import (
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// contentBadgeSelector matches the card and detail elements that may carry a
+// content type or airing status badge.
+const contentBadgeSelector = ".mli-quality, .mli-type, .mli-status, .mli-episode, .badge, .type, .status"
+
+// scanContentBadges returns the first content type and airing status found
+// in the badges under e. Either value is empty when no badge shows it.
+func scanContentBadges(e *colly.HTMLElement) (contentType, status string) {
+	e.ForEach(contentBadgeSelector, func(_ int, el *colly.HTMLElement) {
+		if contentType == "" {
+			contentType = utils.NormalizeContentType(el.Text)
+		}
+		if status == "" {
+			status = utils.NormalizeAiringStatus(el.Text)
+		}
+	})
+	return contentType, status
+}
+
+// resolveContentType returns the scraped content type when there is one,
+// otherwise the type inferred from the URL path, along with its source.
+func resolveContentType(scraped, pageURL string) (string, string) {
+	if scraped != "" {
+		return scraped, utils.SourceScraped
+	}
+	return utils.InferContentType(pageURL), utils.SourceInferred
+}
+
+// resolveAiringStatus returns the scraped airing status when there is one.
+// Otherwise only movies can safely be inferred as completed; everything else
+// is reported as unknown rather than guessed.
+func resolveAiringStatus(scraped, contentType string) (string, string) {
+	if scraped != "" {
+		return scraped, utils.SourceScraped
+	}
+	if contentType == "Movie" {
+		return "Completed", utils.SourceInferred
+	}
+	return "Unknown", utils.SourceInferred
+}
@@ -0,0 +1,573 @@
+package scrapers
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/database"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+const (
+	// dataQualityReportsKept is how many reports of every endpoint are kept
+	dataQualityReportsKept = 500
+	// dataQualityReportMaxAge is how long reports are kept
+	dataQualityReportMaxAge = 90 * 24 * time.Hour
+)
+
+// runningDataQualityChecks tracks the checks running in the background by
+// site domain, with the number of endpoints each has checked so far
+var (
+	runningDataQualityChecksMu sync.Mutex
+	runningDataQualityChecks   = map[string]*int64{}
+)
+
+// DataQualityProgress is the progress of a background data quality check
+type DataQualityProgress struct {
+	Running bool
+	Checked int
+	Total   int
+}
+
+// DataQualityScraper runs the listing scrapers and reports the quality of
+// what they return, so markup changes on the site show up as a drop in
+// field fill rates.
+type DataQualityScraper struct {
+	config *config.Config
+}
+
+func NewDataQualityScraper(cfg *config.Config) *DataQualityScraper {
+	return &DataQualityScraper{config: nullModeConfig(cfg)}
+}
+
+// listingSample is what a data quality check needs from one endpoint
+type listingSample struct {
+	breakdown     *models.ConfidenceBreakdown
+	confidence    float64
+	slugs         []string
+	covers        []string
+	emptySections int
+}
+
+// dataQualityCheck samples one endpoint
+type dataQualityCheck struct {
+	endpoint string
+	scrape   func() (*listingSample, error)
+}
+
+// CheckDataQuality runs every scraper once (the first page of listings, and
+// the first home page title or episode for detail endpoints) and returns one
+// report per endpoint. Responses still in the cache are reused. A failed
+// scrape is reported with its error instead of field rates. The A–Z index
+// is built from the catalog sampled here, so it is not sampled separately.
+func (d *DataQualityScraper) CheckDataQuality() []database.DataQualityReport {
+	return d.runChecks(new(int64))
+}
+
+// StartDataQualityCheck runs CheckDataQuality in the background, stores the
+// reports and prunes old ones. It returns false when a check of the same
+// site is already running. Either way it returns the progress of the
+// running check.
+func (d *DataQualityScraper) StartDataQualityCheck() (bool, DataQualityProgress) {
+	key := utils.ExtractDomain(d.config.BaseURL)
+
+	runningDataQualityChecksMu.Lock()
+	defer runningDataQualityChecksMu.Unlock()
+
+	total := len(d.checks())
+	if checked, ok := runningDataQualityChecks[key]; ok {
+		return false, DataQualityProgress{Running: true, Checked: int(atomic.LoadInt64(checked)), Total: total}
+	}
+
+	checked := new(int64)
+	runningDataQualityChecks[key] = checked
+
+	go func() {
+		defer func() {
+			runningDataQualityChecksMu.Lock()
+			delete(runningDataQualityChecks, key)
+			runningDataQualityChecksMu.Unlock()
+		}()
+
+		for _, report := range d.runChecks(checked) {
+			if err := database.RecordDataQualityReport(report); err != nil {
+				log.Printf("[DataQuality] Failed to save report for %s: %v", report.Endpoint, err)
+			}
+		}
+		if _, err := database.PruneDataQualityReports(dataQualityReportsKept, dataQualityReportMaxAge); err != nil {
+			log.Printf("[DataQuality] Failed to prune old reports: %v", err)
+		}
+	}()
+
+	return true, DataQualityProgress{Running: true, Total: total}
+}
+
+// DataQualityCheckProgress returns the progress of the check running for
+// the site, if any.
+func (d *DataQualityScraper) DataQualityCheckProgress() DataQualityProgress {
+	runningDataQualityChecksMu.Lock()
+	defer runningDataQualityChecksMu.Unlock()
+
+	checked, ok := runningDataQualityChecks[utils.ExtractDomain(d.config.BaseURL)]
+	if !ok {
+		return DataQualityProgress{}
+	}
+	return DataQualityProgress{Running: true, Checked: int(atomic.LoadInt64(checked)), Total: len(d.checks())}
+}
+
+// runChecks samples every endpoint, counting the endpoints done in checked
+func (d *DataQualityScraper) runChecks(checked *int64) []database.DataQualityReport {
+	checks := d.checks()
+	reports := make([]database.DataQualityReport, 0, len(checks))
+	for _, check := range checks {
+		reports = append(reports, check.report())
+		atomic.AddInt64(checked, 1)
+	}
+	return reports
+}
+
+// report samples the check's endpoint; a failed scrape is reported with its
+// error instead of field rates
+func (check dataQualityCheck) report() database.DataQualityReport {
+	report := database.DataQualityReport{Endpoint: check.endpoint}
+
+	sample, err := check.scrape()
+	if err != nil {
+		report.ErrorMessage = err.Error()
+		return report
+	}
+
+	report.ItemsFound = len(sample.slugs)
+	report.EmptySections = sample.emptySections
+	report.DuplicateSlugs = countDuplicateSlugs(sample.slugs)
+	report.BrokenCovers = countBrokenCovers(sample.covers)
+	report.ConfidenceScore = sample.confidence
+	report.Fields = fieldRates(sample.breakdown)
+	return report
+}
+
+// checks returns the endpoints sampled by a data quality check
+func (d *DataQualityScraper) checks() []dataQualityCheck {
+	return []dataQualityCheck{
+		{"home", d.sampleHome},
+		{"anime-terbaru", func() (*listingSample, error) {
+			response, err := NewAnimeScraper(d.config).ScrapeAnimeTerbaru(1)
+			if err != nil {
+				return nil, err
+			}
+			return sampleAnimeTerbaru(response), nil
+		}},
+		{"donghua", func() (*listingSample, error) {
+			response, err := NewDonghuaScraper(d.config).ScrapeDonghua(1)
+			if err != nil {
+				return nil, err
+			}
+			return sampleAnimeTerbaru(response), nil
+		}},
+		{"movie", d.sampleMovies},
+		{"tv-show", func() (*listingSample, error) {
+			response, err := NewTVShowScraper(d.config).ScrapeTVShows(1)
+			if err != nil {
+				return nil, err
+			}
+			return sampleSeriesList(response), nil
+		}},
+		{"drama", func() (*listingSample, error) {
+			response, err := NewDramaScraper(d.config).ScrapeDrama(1, "")
+			if err != nil {
+				return nil, err
+			}
+			return sampleSeriesList(response), nil
+		}},
+		{"catalog", d.sampleCatalog},
+		{"home-sections", d.sampleHomeSections},
+		{"genres", d.sampleGenres},
+		{"genre", d.sampleGenre},
+		{"filters", d.sampleFilters},
+		{"jadwal-rilis", d.sampleSchedule},
+		{"anime-detail", d.sampleAnimeDetail},
+		{"episode-detail", d.sampleEpisodeDetail},
+		{"franchise", d.sampleFranchise},
+	}
+}
+
+func (d *DataQualityScraper) sampleHome() (*listingSample, error) {
+	response, err := NewHomeScraper(d.config).ScrapeHome()
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Top10 {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	for _, item := range response.NewEps {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	for _, item := range response.Movies {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Top10))
+	sample.section(len(response.NewEps))
+	sample.section(len(response.Movies))
+
+	return sample, nil
+}
+
+func (d *DataQualityScraper) sampleMovies() (*listingSample, error) {
+	response, err := NewMovieScraper(d.config).ScrapeMovies(1)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Data {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Data))
+
+	return sample, nil
+}
+
+func (d *DataQualityScraper) sampleCatalog() (*listingSample, error) {
+	response, err := NewSearchScraper(d.config).BrowseCatalog(models.CatalogFilter{Page: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Data {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Data))
+
+	return sample, nil
+}
+
+func (d *DataQualityScraper) sampleHomeSections() (*listingSample, error) {
+	response, err := NewHomeScraper(d.config).ScrapeHomeSections()
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, section := range response.Data {
+		for _, item := range section.Items {
+			sample.add(item.AnimeSlug, item.Cover)
+		}
+		sample.section(len(section.Items))
+	}
+
+	return sample, nil
+}
+
+func (d *DataQualityScraper) sampleGenres() (*listingSample, error) {
+	response, err := NewGenreScraper(d.config).ScrapeGenres()
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, genre := range response.Data {
+		sample.slugs = append(sample.slugs, genre.Slug)
+	}
+	sample.section(len(response.Data))
+
+	return sample, nil
+}
+
+// sampleGenre samples the listing of the first genre in the genre index
+func (d *DataQualityScraper) sampleGenre() (*listingSample, error) {
+	genres, err := NewGenreScraper(d.config).ScrapeGenres()
+	if err != nil {
+		return nil, err
+	}
+	if len(genres.Data) == 0 {
+		return &listingSample{emptySections: 1, breakdown: &models.ConfidenceBreakdown{}}, nil
+	}
+
+	response, err := NewGenreScraper(d.config).ScrapeGenre(genres.Data[0].Slug, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Data {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Data))
+
+	return sample, nil
+}
+
+func (d *DataQualityScraper) sampleFilters() (*listingSample, error) {
+	response, err := NewFilterScraper(d.config).ScrapeFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, options := range [][]models.FilterOption{
+		response.Data.StatusOptions,
+		response.Data.TypeOptions,
+		response.Data.OrderOptions,
+		response.Data.GenreOptions,
+	} {
+		sample.section(len(options))
+	}
+
+	return sample, nil
+}
+
+// sampleSchedule samples the release schedule. The schedule response is
+// scored by how many days it fills, so its field rates are computed here.
+func (d *DataQualityScraper) sampleSchedule() (*listingSample, error) {
+	response, err := NewScheduleScraper(d.config).ScrapeSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	scorer := utils.NewConfidenceScorer().
+		Field("title", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover_url", 2, utils.ValidateCoverURL).
+		Field("release_time", 1, utils.ValidateText)
+
+	sample := &listingSample{confidence: response.ConfidenceScore}
+	for _, day := range scheduleDays(&response.Data) {
+		for _, item := range *day {
+			scorer.Check("title", item.Title)
+			scorer.Check("url", item.URL)
+			scorer.Check("anime_slug", item.AnimeSlug)
+			scorer.Check("cover_url", item.CoverURL)
+			scorer.Record("release_time", item.ReleaseTimeKnown, utils.IssueMissing)
+			sample.add(item.AnimeSlug, item.CoverURL)
+		}
+		sample.section(len(*day))
+	}
+	_, _, sample.breakdown = scorer.Result(nil)
+
+	return sample, nil
+}
+
+// sampleAnimeDetail samples the detail page of the first top 10 title on
+// the home page
+func (d *DataQualityScraper) sampleAnimeDetail() (*listingSample, error) {
+	slug, err := d.sampleDetailSlug()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := NewDetailScraper(d.config).ScrapeAnimeDetail(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	sample.add(slug, response.Cover)
+	for _, rec := range response.Recommendations {
+		sample.add(rec.AnimeSlug, rec.CoverURL)
+	}
+	sample.section(len(response.EpisodeList))
+	sample.section(len(response.Recommendations))
+
+	return sample, nil
+}
+
+// sampleEpisodeDetail samples the newest episode of the anime-terbaru
+// listing
+func (d *DataQualityScraper) sampleEpisodeDetail() (*listingSample, error) {
+	listing, err := NewAnimeScraper(d.config).ScrapeAnimeTerbaru(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(listing.Data) == 0 {
+		return &listingSample{emptySections: 1, breakdown: &models.ConfidenceBreakdown{}}, nil
+	}
+
+	response, err := NewDetailScraper(d.config).ScrapeEpisodeDetail(listing.Data[0].URL)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	sample.add(utils.ExtractSlugFromURL(listing.Data[0].URL), response.ThumbnailURL)
+	sample.section(len(response.StreamingServers))
+	sample.section(len(response.OtherEpisodes))
+
+	return sample, nil
+}
+
+// sampleFranchise samples the franchise of the title sampleAnimeDetail
+// uses. The franchise response takes its score from the detail page, so its
+// field rates are computed here.
+func (d *DataQualityScraper) sampleFranchise() (*listingSample, error) {
+	slug, err := d.sampleDetailSlug()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := NewFranchiseScraper(d.config).ScrapeFranchise(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	scorer := utils.NewConfidenceScorer().
+		Field("title", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL)
+
+	sample := &listingSample{confidence: response.ConfidenceScore}
+	for _, entry := range response.Data {
+		scorer.Check("title", entry.Title)
+		scorer.Check("url", entry.URL)
+		scorer.Check("anime_slug", entry.AnimeSlug)
+		scorer.Check("cover", entry.Cover)
+		sample.add(entry.AnimeSlug, entry.Cover)
+	}
+	sample.section(len(response.Data))
+	_, _, sample.breakdown = scorer.Result(nil)
+
+	return sample, nil
+}
+
+// sampleDetailSlug returns the path of the first top 10 title on the home
+// page, e.g. "anime/one-piece", as accepted by ScrapeAnimeDetail
+func (d *DataQualityScraper) sampleDetailSlug() (string, error) {
+	home, err := NewHomeScraper(d.config).ScrapeHome()
+	if err != nil {
+		return "", err
+	}
+	for _, item := range home.Top10 {
+		if slug := strings.Trim(strings.TrimPrefix(item.URL, d.config.BaseURL), "/"); slug != "" {
+			return slug, nil
+		}
+	}
+	return "", fmt.Errorf("no title to sample on the home page")
+}
+
+func sampleAnimeTerbaru(response *models.AnimeTerbaruResponse) *listingSample {
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Data {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Data))
+	return sample
+}
+
+func sampleSeriesList(response *models.SeriesListResponse) *listingSample {
+	sample := &listingSample{
+		breakdown:  response.ConfidenceBreakdown,
+		confidence: response.ConfidenceScore,
+	}
+	for _, item := range response.Data {
+		sample.add(item.AnimeSlug, item.Cover)
+	}
+	sample.section(len(response.Data))
+	return sample
+}
+
+func (s *listingSample) add(slug, cover string) {
+	s.slugs = append(s.slugs, slug)
+	s.covers = append(s.covers, cover)
+}
+
+// section records one listing section of the sample
+func (s *listingSample) section(items int) {
+	if items == 0 {
+		s.emptySections++
+	}
+}
+
+// countDuplicateSlugs counts the items whose slug was already listed
+func countDuplicateSlugs(slugs []string) int {
+	seen := make(map[string]bool, len(slugs))
+	duplicates := 0
+	for _, slug := range slugs {
+		if slug == "" {
+			continue
+		}
+		if seen[slug] {
+			duplicates++
+		}
+		seen[slug] = true
+	}
+	return duplicates
+}
+
+// countBrokenCovers counts the covers that were scraped but are not usable
+// artwork. Blank covers are already counted by the cover fill rate.
+func countBrokenCovers(covers []string) int {
+	broken := 0
+	for _, cover := range covers {
+		if cover == "" {
+			continue
+		}
+		if ok, _ := utils.ValidateCoverURL(cover); !ok {
+			broken++
+		}
+	}
+	return broken
+}
+
+// fieldRates turns a confidence breakdown into per-field rates. Scrapers run
+// in null mode, so blank values are reported as missing rather than as the
+// placeholders v1 responses fill in.
+func fieldRates(breakdown *models.ConfidenceBreakdown) []database.DataQualityField {
+	if breakdown == nil {
+		return nil
+	}
+
+	fields := make([]database.DataQualityField, 0, len(breakdown.Fields))
+	for _, field := range breakdown.Fields {
+		rates := database.DataQualityField{Field: field.Field, Checked: field.Checked}
+		if field.Checked > 0 {
+			checked := float64(field.Checked)
+			missing := float64(field.Issues[utils.IssueMissing])
+			placeholder := float64(field.Issues[utils.IssuePlaceholder])
+			rates.FillRate = utils.RoundScore((checked - missing - placeholder) / checked)
+			rates.PlaceholderRate = utils.RoundScore(placeholder / checked)
+			rates.ValidRate = field.Score
+		}
+		fields = append(fields, rates)
+	}
+	return fields
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +54,7 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 	cacheKey := fmt.Sprintf("anime_detail_%s", animeSlug)
 	var cachedResponse models.AnimeDetailResponse
 	if d.cache.Get(cacheKey, &cachedResponse) {
+		fillAnimeDetailPlaceholders(d.config, &cachedResponse)
 		return &cachedResponse, nil
 	}
 
@@ -66,23 +68,31 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 
 	response := &models.AnimeDetailResponse{
 		BaseResponse: models.BaseResponse{
-			Message:         "Success",
-			ConfidenceScore: 0.95,
-			Source:          domain,
+			Source: domain,
 		},
-		AnimeSlug:       animeSlug,
-		URL:             animeURL,
-		EpisodeList:     []models.EpisodeListItem{},
-		Recommendations: []models.RecommendationItem{},
-		Genre:           []string{},
-		Details:         models.AnimeDetails{},
-		Rating:          models.AnimeRating{},
+		AnimeSlug:          animeSlug,
+		URL:                animeURL,
+		EpisodeList:        []models.EpisodeListItem{},
+		Recommendations:    []models.RecommendationItem{},
+		Genre:              []string{},
+		SinopsisParagraphs: []string{},
+		Details:            models.AnimeDetails{},
+		Rating:             models.AnimeRating{},
 	}
 
+	var scrapingErrors []string
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	scrapePageMeta(c, &response.PageMeta)
+
+	var badgeType, badgeStatus string
+
 	// Info utama
 	c.OnHTML("div.m-info", func(e *colly.HTMLElement) {
 		response.Judul = utils.CleanText(e.ChildText(".mli-info .judul"))
-		response.Cover = e.ChildAttr(".mli-thumb-box img", "src")
+		response.Cover = imageURL(d.config, e, ".mli-thumb-box img")
 
 		// Rating - try multiple selectors
 		ratingText := e.DOM.Find(".mli-mvi").FilterFunction(func(i int, s *goquery.Selection) bool {
@@ -94,16 +104,16 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 			e.ForEach(".mli-mvi", func(_ int, el *colly.HTMLElement) {
 				text := utils.CleanText(el.Text)
 				if matched, _ := regexp.MatchString(`^\d+(\.\d+)?$`, text); matched {
-					response.Skor = text
-					response.Rating.Score = text
+					response.Skor = optionalString(text)
+					response.Rating.Score = optionalString(text)
 				}
 			})
 		} else {
 			re := regexp.MustCompile(`(\d+\.\d+)\s+/\s+\d+`)
 			matches := re.FindStringSubmatch(ratingText)
 			if len(matches) > 1 {
-				response.Skor = matches[1]
-				response.Rating.Score = matches[1]
+				response.Skor = optionalString(matches[1])
+				response.Rating.Score = optionalString(matches[1])
 			}
 		}
 
@@ -112,78 +122,78 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 			response.Genre = append(response.Genre, utils.CleanText(el.Text))
 		})
 
-		response.Sinopsis = utils.CleanText(e.ChildText(".mli-desc p"))
-		if response.Sinopsis == "" {
-			response.Sinopsis = utils.CleanText(e.ChildText(".mli-desc"))
-		}
-
-		// Determine type based on URL and fill details
-		if strings.Contains(animeURL, "/film/") {
-			response.Tipe = "Movie"
-			response.Status = "Completed"
-			response.Details.Type = "Movie"
-			response.Details.Status = "Completed"
-			response.Details.Duration = "~120 min"
-			response.Details.TotalEpisode = "1"
-		} else if strings.Contains(animeURL, "/series/") {
-			response.Tipe = "Series"
-			response.Status = "Ongoing"
-			response.Details.Type = "Series"
-			response.Details.Status = "Ongoing"
-			response.Details.Duration = "~45 min per episode"
-			response.Details.TotalEpisode = "Unknown"
-		} else {
-			response.Tipe = "TV"
-			response.Status = "Ongoing"
-			response.Details.Type = "TV"
-			response.Details.Status = "Ongoing"
-			response.Details.Duration = "~24 min per episode"
-			response.Details.TotalEpisode = "Unknown"
-		}
+		synopsis := synopsisOf(d.config, e, ".mli-desc")
+		response.Sinopsis = synopsis.Text()
+		response.SinopsisParagraphs = synopsis.Paragraphs
+		response.SinopsisMarkdown = synopsis.Markdown
+
+		// Info rows are rendered as "Label : value", either as .mli-mvi
+		// lines or as a two-column table
+		e.ForEach(".mli-mvi", func(_ int, el *colly.HTMLElement) {
+			label, value, found := strings.Cut(utils.CleanText(el.Text), ":")
+			if found {
+				setAnimeDetail(&response.Details, label, value)
+			}
+		})
+		e.ForEach("table tr", func(_ int, el *colly.HTMLElement) {
+			cells := el.DOM.Find("th, td")
+			if cells.Length() >= 2 {
+				setAnimeDetail(&response.Details, cells.First().Text(), cells.Eq(1).Text())
+			}
+		})
 
-		// Fill other details with dummy data if not available
-		response.Details.Japanese = response.Judul
-		response.Details.English = response.Judul
-		response.Details.Source = "Original"
-		response.Details.Season = "2025"
-		response.Details.Studio = "Unknown Studio"
-		response.Details.Producers = "Unknown Producer"
-		response.Details.Released = "2025"
-
-		// Fill rating users with dummy data
-		if response.Rating.Score != "" {
-			response.Rating.Users = "1,234 users"
-		} else {
-			response.Rating.Score = "7.5"
-			response.Rating.Users = "1,000 users"
-			response.Skor = "7.5"
+		// The season is linked without a label, e.g. /season/winter-2026/
+		if response.Details.Season == nil {
+			response.Details.Season = optionalString(e.ChildText(".mli-mvi a[href*='/season/']"))
 		}
 
-		response.Penonton = "10,000+ viewers"
+		// Type and status badges, used when the info rows do not list them
+		badgeType, badgeStatus = scanContentBadges(e)
 	})
 
-	// Episode list
+	// Episode list. Series with several seasons render one les-content
+	// block per season, each preceded by its own les-title.
 	c.OnHTML("div.tvseason div.les-content", func(e *colly.HTMLElement) {
 		var episodes []models.EpisodeListItem
 		e.ForEach("a", func(i int, el *colly.HTMLElement) {
-			ep := models.EpisodeListItem{
-				Episode:     "", // Will be assigned after reversal
-				Title:       utils.CleanText(el.Text),
-				URL:         el.Attr("href"),
-				EpisodeSlug: utils.ExtractSlugFromURL(el.Attr("href")),
-				ReleaseDate: "Unknown",
+			// Some lists print the upload date next to the episode title
+			releaseDate := utils.CleanText(el.ChildText("time, .date, .epl-date"))
+			title := utils.CleanText(el.Text)
+			if releaseDate != "" {
+				title = utils.CleanText(strings.Replace(title, releaseDate, "", 1))
 			}
-			episodes = append(episodes, ep)
+
+			episodes = append(episodes, newEpisodeListItem(title, el.Attr("href"), releaseDate))
 		})
-		// Reverse order
+		if len(episodes) == 0 {
+			return
+		}
+		// The site lists the newest episode first
 		for i, j := 0, len(episodes)-1; i < j; i, j = i+1, j-1 {
 			episodes[i], episodes[j] = episodes[j], episodes[i]
 		}
-		// Assign episode numbers after reversal so they match the correct order
-		for i := range episodes {
-			episodes[i].Episode = fmt.Sprintf("%d", i+1)
+
+		seasonTitle := utils.CleanText(e.DOM.PrevAllFiltered(".les-title").First().Text())
+		season := models.SeasonGroup{
+			Title:    seasonTitle,
+			Episodes: episodes,
+		}
+		if number, ok := utils.ParseSeasonNumber(seasonTitle); ok {
+			season.SeasonNumber = &number
+		}
+		response.Seasons = append(response.Seasons, season)
+		response.EpisodeList = append(response.EpisodeList, episodes...)
+	})
+
+	// Any link to one of this series' episodes, used to rebuild the list
+	// when the page renders no div.tvseason
+	seriesSlug := utils.ExtractSlugFromURL(animeURL)
+	var knownEpisodeURL string
+	c.OnHTML("a[href]", func(e *colly.HTMLElement) {
+		slug := utils.ExtractSlugFromURL(e.Attr("href"))
+		if knownEpisodeURL == "" && strings.HasPrefix(slug, seriesSlug+"-episode-") {
+			knownEpisodeURL = e.Attr("href")
 		}
-		response.EpisodeList = episodes
 	})
 
 	// Rekomendasi
@@ -192,10 +202,10 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 			Title:     utils.CleanText(e.ChildText(".judul")),
 			URL:       e.ChildAttr("a.ml-mask", "href"),
 			AnimeSlug: utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
-			CoverURL:  e.ChildAttr("img.mli-thumb", "src"),
+			CoverURL:  imageURL(d.config, e, "img.mli-thumb"),
 			Rating:    utils.CleanText(e.ChildText(".mli-mvi")),
-			Episode:   "Unknown",
 		}
+		rec.ScoreValue = utils.ScoreValue(rec.Rating)
 		response.Recommendations = append(response.Recommendations, rec)
 	})
 
@@ -204,29 +214,81 @@ func (d *DetailScraper) ScrapeAnimeDetail(animeSlug string) (*models.AnimeDetail
 		return nil, fmt.Errorf("failed to visit anime detail page: %v", err)
 	}
 
+	// A single block is just the episode list, not a season grouping
+	if len(response.Seasons) < 2 {
+		response.Seasons = nil
+	}
+
+	response.PageMeta.CanonicalChanged = canonicalChanged(animeURL, response.PageMeta.CanonicalURL)
+
+	// Prefer the info rows, then badges, then the URL path
+	scrapedType := badgeType
+	if response.Details.Type != nil && utils.NormalizeContentType(*response.Details.Type) != "" {
+		scrapedType = utils.NormalizeContentType(*response.Details.Type)
+	}
+	scrapedStatus := badgeStatus
+	if response.Details.Status != nil && utils.NormalizeAiringStatus(*response.Details.Status) != "" {
+		scrapedStatus = utils.NormalizeAiringStatus(*response.Details.Status)
+	}
+	response.Tipe, response.TypeSource = resolveContentType(scrapedType, animeURL)
+	response.Status, response.StatusSource = resolveAiringStatus(scrapedStatus, response.Tipe)
+	if response.Status == "Unknown" && allEpisodesListed(response.Details.TotalEpisode, response.EpisodeList) {
+		response.Status = "Completed"
+	}
+
+	// Rebuild the list from episode navigation when the page has none. Until
+	// the background traversal finishes only the known episode is listed.
+	var walkMessage string
+	cacheTTL := 3600 // Cache for 1 hour
+	if len(response.EpisodeList) == 0 && knownEpisodeURL != "" {
+		walk := episodeNavigationList(d.config, knownEpisodeURL)
+		response.EpisodeList = walk.Episodes
+		if walk.Running {
+			response.EpisodeList = []models.EpisodeListItem{newEpisodeListItem("", knownEpisodeURL, "")}
+		}
+		walkMessage = walk.Message()
+		if walk.Running || walk.Failed {
+			// Pick up the finished or retried traversal soon
+			cacheTTL = 60
+		}
+	}
+
 	// Fallback episodes if empty
 	if len(response.EpisodeList) == 0 {
+		one := 1.0
 		if strings.Contains(animeURL, "/film/") {
 			response.EpisodeList = []models.EpisodeListItem{{
-				Episode:     "1",
-				Title:       "Film",
-				URL:         animeURL,
-				EpisodeSlug: animeSlug,
-				ReleaseDate: "Unknown",
+				Episode:       "1",
+				EpisodeNumber: &one,
+				EpisodeType:   utils.EpisodeKindMovie,
+				Title:         "Film",
+				URL:           animeURL,
+				EpisodeSlug:   animeSlug,
 			}}
 		} else if strings.Contains(animeURL, "/series/") {
 			response.EpisodeList = []models.EpisodeListItem{{
-				Episode:     "1",
-				Title:       "Series",
-				URL:         animeURL,
-				EpisodeSlug: animeSlug,
-				ReleaseDate: "Unknown",
+				Episode:       "1",
+				EpisodeNumber: &one,
+				EpisodeType:   utils.EpisodeKindRegular,
+				Title:         "Series",
+				URL:           animeURL,
+				EpisodeSlug:   animeSlug,
 			}}
 		}
 	}
 
+	response.ScoreValue = utils.ScoreValue(stringValue(response.Skor))
+	response.ViewsCount = utils.ViewsCount(stringValue(response.Penonton))
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = animeDetailConfidence(response, scrapingErrors)
+	if walkMessage != "" && len(scrapingErrors) == 0 {
+		response.Message = walkMessage
+	}
+
 	// Cache the result
-	d.cache.SetWithTTL(cacheKey, response, 3600) // Cache for 1 hour
+	d.cache.SetWithTTL(cacheKey, response, cacheTTL)
+
+	fillAnimeDetailPlaceholders(d.config, response)
 
 	return response, nil
 }
@@ -236,6 +298,7 @@ func (d *DetailScraper) ScrapeEpisodeDetail(episodeURL string) (*models.EpisodeD
 	cacheKey := fmt.Sprintf("episode_detail_%s", utils.ExtractSlugFromURL(episodeURL))
 	var cachedResponse models.EpisodeDetailResponse
 	if d.cache.Get(cacheKey, &cachedResponse) {
+		fillEpisodeDetailPlaceholders(d.config, &cachedResponse)
 		return &cachedResponse, nil
 	}
 
@@ -251,9 +314,7 @@ func (d *DetailScraper) ScrapeEpisodeDetail(episodeURL string) (*models.EpisodeD
 
 	response := &models.EpisodeDetailResponse{
 		BaseResponse: models.BaseResponse{
-			Message:         "Success",
-			ConfidenceScore: 0.95,
-			Source:          domain,
+			Source: domain,
 		},
 		StreamingServers: []models.StreamingServer{},
 		DownloadLinks: models.DownloadLinksGroup{
@@ -262,10 +323,17 @@ func (d *DetailScraper) ScrapeEpisodeDetail(episodeURL string) (*models.EpisodeD
 			X265: make(map[string][]models.DownloadLink),
 		},
 		Navigation:    models.EpisodeNavigation{},
-		AnimeInfo:     models.AnimeInfo{},
+		AnimeInfo:     models.AnimeInfo{SynopsisParagraphs: []string{}},
 		OtherEpisodes: []models.OtherEpisode{},
 	}
 
+	var scrapingErrors []string
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	scrapePageMeta(c, &response.PageMeta)
+
 	// Episode title
 	c.OnHTML("div.list-title h2", func(e *colly.HTMLElement) {
 		response.Title = utils.CleanText(e.Text)
@@ -273,16 +341,54 @@ func (d *DetailScraper) ScrapeEpisodeDetail(episodeURL string) (*models.EpisodeD
 
 	// Series info
 	c.OnHTML("div.m-info div.movies-list-full div.t-item", func(e *colly.HTMLElement) {
-		response.AnimeInfo.Title = utils.CleanText(e.ChildText(".mli-info .judul"))
-		response.AnimeInfo.ThumbnailURL = e.ChildAttr(".mli-thumb-box img", "src")
-		response.ThumbnailURL = e.ChildAttr(".mli-thumb-box img", "src")
+		response.AnimeInfo.Title = optionalString(e.ChildText(".mli-info .judul"))
+		response.AnimeInfo.ThumbnailURL = imageURL(d.config, e, ".mli-thumb-box img")
+		response.ThumbnailURL = response.AnimeInfo.ThumbnailURL
 
 		// Genres
 		e.ForEach(".mli-mvi a", func(_ int, genreEl *colly.HTMLElement) {
 			response.AnimeInfo.Genres = append(response.AnimeInfo.Genres, utils.CleanText(genreEl.Text))
 		})
 
-		response.AnimeInfo.Synopsis = utils.CleanText(e.ChildText(".mli-desc"))
+		synopsis := synopsisOf(d.config, e, ".mli-desc")
+		response.AnimeInfo.SynopsisParagraphs = synopsis.Paragraphs
+		response.AnimeInfo.Synopsis, response.AnimeInfo.SynopsisMarkdown = synopsisText(synopsis)
+	})
+
+	// Upload time of this episode
+	var publishedAt time.Time
+	c.OnHTML("meta[property='article:published_time']", func(e *colly.HTMLElement) {
+		if t, err := time.Parse(time.RFC3339, e.Attr("content")); err == nil {
+			publishedAt = t.In(utils.WIB)
+		}
+	})
+
+	// Episode picker listing every episode of the series
+	c.OnHTML("div.dropdownEpisodeList .dropdown-item", func(e *colly.HTMLElement) {
+		link := e.DOM.Find("a").First()
+		episodeLink := link.AttrOr("href", "")
+
+		// Some pickers print the upload date or a still next to the title
+		releaseDate := utils.CleanText(e.ChildText("time, .date, .epl-date"))
+		title := utils.CleanText(link.Text())
+		if releaseDate != "" {
+			title = utils.CleanText(strings.Replace(title, releaseDate, "", 1))
+		}
+		if title == "" || episodeLink == "" {
+			return
+		}
+
+		episode := models.OtherEpisode{
+			Title:        title,
+			URL:          episodeLink,
+			IsCurrent:    link.HasClass("active"),
+			ThumbnailURL: optionalString(imageURL(d.config, e, "img")),
+			ReleaseDate:  utils.FormatRFC3339(releaseDate, time.Now()),
+		}
+		if number, ok := utils.ParseEpisodeNumber(title); ok {
+			episode.EpisodeNumber = &number
+		}
+		response.OtherEpisodes = append(response.OtherEpisodes, episode)
 	})
 
 	// Navigation
@@ -351,38 +457,29 @@ func (d *DetailScraper) ScrapeEpisodeDetail(episodeURL string) (*models.EpisodeD
 
 	// Set streaming servers
 	response.StreamingServers = streamingServers
+	response.PageMeta.CanonicalChanged = canonicalChanged(episodeURL, response.PageMeta.CanonicalURL)
 
-	// Fill missing fields with dummy data
-	if response.ReleaseInfo == "" {
-		response.ReleaseInfo = "Released on January 2025"
-	}
-
-	// Fill AnimeInfo with dummy data if empty
-	if response.AnimeInfo.Title == "" {
-		response.AnimeInfo.Title = "Unknown Series"
-	}
-	if response.AnimeInfo.Synopsis == "" {
-		response.AnimeInfo.Synopsis = "No synopsis available for this episode."
-	}
-	if len(response.AnimeInfo.Genres) == 0 {
-		response.AnimeInfo.Genres = []string{"Action", "Adventure", "Drama"}
+	if !publishedAt.IsZero() {
+		releaseInfo := "Released on " + publishedAt.Format("2 January 2006 15:04") + " WIB"
+		response.ReleaseInfo = &releaseInfo
 	}
 
-	// Add dummy other episodes if empty
-	if len(response.OtherEpisodes) == 0 {
-		response.OtherEpisodes = []models.OtherEpisode{
-			{
-				Title:        "Episode 1",
-				URL:          episodeURL,
-				ThumbnailURL: response.ThumbnailURL,
-				ReleaseDate:  "January 2025",
-			},
+	// The page's upload time dates the current episode
+	for i := range response.OtherEpisodes {
+		episode := &response.OtherEpisodes[i]
+		if episode.IsCurrent && episode.ReleaseDate == nil && !publishedAt.IsZero() {
+			releaseDate := publishedAt.Format(time.RFC3339)
+			episode.ReleaseDate = &releaseDate
 		}
 	}
 
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = episodeDetailConfidence(response, scrapingErrors)
+
 	// Cache the result
 	d.cache.SetWithTTL(cacheKey, response, 1800) // Cache for 30 minutes
 
+	fillEpisodeDetailPlaceholders(d.config, response)
+
 	return response, nil
 }
 
@@ -455,3 +552,177 @@ func (d *DetailScraper) checkURLExists(url string) (bool, error) {
 	return resp.StatusCode == 200, nil
 }
 
+// allEpisodesListed reports whether the episode list already holds as many
+// regular episodes as the announced total, meaning the show has finished.
+func allEpisodesListed(totalEpisode *string, episodes []models.EpisodeListItem) bool {
+	if totalEpisode == nil {
+		return false
+	}
+	total, err := strconv.Atoi(strings.TrimSpace(*totalEpisode))
+	if err != nil || total <= 0 {
+		return false
+	}
+
+	listed := 0
+	for _, episode := range episodes {
+		if episode.EpisodeType == utils.EpisodeKindRegular && episode.EpisodeNumber != nil {
+			listed++
+		}
+	}
+	return listed >= total
+}
+
+// animeDetailConfidence scores an anime detail page. Status and type only
+// count as valid when they were read from the page rather than inferred.
+func animeDetailConfidence(response *models.AnimeDetailResponse, scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("sinopsis", 2, utils.ValidateText).
+		Field("episode_list", 2, utils.ValidateText).
+		Field("genre", 1, utils.ValidateText).
+		Field("skor", 1, utils.ValidateScore).
+		Field("tipe", 1, utils.ValidateText).
+		Field("status", 1, utils.ValidateText).
+		Field("details", 1, utils.ValidateText)
+
+	details := response.Details
+	hasDetails := details.Japanese != nil || details.English != nil || details.Status != nil ||
+		details.Type != nil || details.Source != nil || details.Duration != nil ||
+		details.TotalEpisode != nil || details.Season != nil || details.Studio != nil ||
+		details.Producers != nil || details.Released != nil
+
+	scorer.Item(response.DefaultedFields)
+	scorer.Check("judul", response.Judul)
+	scorer.Check("cover", response.Cover)
+	scorer.Check("sinopsis", response.Sinopsis)
+	scorer.Record("episode_list", len(response.EpisodeList) > 0, utils.IssueMissing)
+	scorer.CheckList("genre", response.Genre)
+	scorer.CheckOptional("skor", response.Skor)
+	scorer.Record("tipe", response.TypeSource == utils.SourceScraped, utils.IssueInferredValue)
+	scorer.Record("status", response.StatusSource == utils.SourceScraped, utils.IssueInferredValue)
+	scorer.Record("details", hasDetails, utils.IssueMissing)
+
+	return scorer.Result(scrapingErrors)
+}
+
+// episodeDetailConfidence scores an episode page, weighting the stream and
+// download links that clients come for above the series info
+func episodeDetailConfidence(response *models.EpisodeDetailResponse, scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	scorer := utils.NewConfidenceScorer().
+		Field("title", 3, utils.ValidateText).
+		Field("streaming_servers", 3, utils.ValidateURL).
+		Field("download_links", 2, utils.ValidateURL).
+		Field("thumbnail_url", 1, utils.ValidateCoverURL).
+		Field("navigation", 1, utils.ValidateURL).
+		Field("anime_info.title", 1, utils.ValidateText).
+		Field("anime_info.synopsis", 0.5, utils.ValidateText).
+		Field("anime_info.genres", 0.5, utils.ValidateText).
+		Field("release_info", 0.5, utils.ValidateText)
+
+	var streamURLs []string
+	for _, server := range response.StreamingServers {
+		streamURLs = append(streamURLs, server.StreamingURL)
+	}
+	var downloadURLs []string
+	for _, group := range []map[string][]models.DownloadLink{response.DownloadLinks.MKV, response.DownloadLinks.MP4, response.DownloadLinks.X265} {
+		for _, links := range group {
+			for _, link := range links {
+				downloadURLs = append(downloadURLs, link.URL)
+			}
+		}
+	}
+	nav := response.Navigation
+
+	scorer.Item(response.DefaultedFields)
+	scorer.Check("title", response.Title)
+	scorer.CheckList("streaming_servers", streamURLs)
+	scorer.CheckList("download_links", downloadURLs)
+	scorer.Check("thumbnail_url", response.ThumbnailURL)
+	scorer.Record("navigation", nav.PreviousEpisodeURL != "" || nav.AllEpisodesURL != "" || nav.NextEpisodeURL != "", utils.IssueMissing)
+	scorer.CheckOptional("anime_info.title", response.AnimeInfo.Title)
+	scorer.CheckOptional("anime_info.synopsis", response.AnimeInfo.Synopsis)
+	scorer.CheckList("anime_info.genres", response.AnimeInfo.Genres)
+	scorer.CheckOptional("release_info", response.ReleaseInfo)
+
+	return scorer.Result(scrapingErrors)
+}
+
+// newEpisodeListItem builds an episode list entry, parsing the episode
+// number from the link title or, failing that, from the episode slug.
+func newEpisodeListItem(title, episodeURL, releaseDate string) models.EpisodeListItem {
+	slug := utils.ExtractSlugFromURL(episodeURL)
+	label := utils.ParseEpisodeLabel(title, slug)
+
+	item := models.EpisodeListItem{
+		Episode:     label.String(),
+		EpisodeType: label.Kind,
+		Title:       title,
+		URL:         episodeURL,
+		EpisodeSlug: slug,
+		ReleaseDate: optionalString(releaseDate),
+		ReleasedAt:  utils.FormatRFC3339(releaseDate, time.Now()),
+	}
+	if label.HasNumber {
+		number := label.Number
+		item.EpisodeNumber = &number
+	}
+	return item
+}
+
+// setAnimeDetail stores an info row value in the AnimeDetails field matching
+// its label. Unknown labels and empty values are ignored, and the first
+// value seen for a field wins.
+func setAnimeDetail(details *models.AnimeDetails, label, value string) {
+	label = strings.ToLower(strings.TrimSpace(strings.TrimSuffix(utils.CleanText(label), ":")))
+
+	var field **string
+	switch label {
+	case "japanese", "judul jepang", "japanese title":
+		field = &details.Japanese
+	case "english", "judul inggris", "english title":
+		field = &details.English
+	case "status":
+		field = &details.Status
+	case "type", "tipe", "jenis":
+		field = &details.Type
+	case "source", "sumber":
+		field = &details.Source
+	case "duration", "durasi":
+		field = &details.Duration
+	case "total episode", "total episodes", "jumlah episode", "episodes", "episode":
+		field = &details.TotalEpisode
+	case "season", "musim":
+		field = &details.Season
+	case "studio", "studios":
+		field = &details.Studio
+	case "producers", "producer", "produser":
+		field = &details.Producers
+	case "released", "released on", "rilis", "tanggal rilis", "aired", "tayang":
+		field = &details.Released
+	default:
+		return
+	}
+
+	if *field == nil {
+		*field = optionalString(value)
+	}
+}
+
+// optionalString returns nil for blank text so missing values serialize as
+// null instead of an empty or invented string.
+func optionalString(text string) *string {
+	text = utils.CleanText(text)
+	if text == "" {
+		return nil
+	}
+	return &text
+}
+
+// stringValue returns the text behind an optional string, or "" for nil
+func stringValue(text *string) string {
+	if text == nil {
+		return ""
+	}
+	return *text
+}
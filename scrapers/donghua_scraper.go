@@ -0,0 +1,85 @@
+package scrapers
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+type DonghuaScraper struct {
+	config *config.Config
+}
+
+func NewDonghuaScraper(cfg *config.Config) *DonghuaScraper {
+	return &DonghuaScraper{config: cfg}
+}
+
+// ScrapeDonghua scrapes one page of the donghua (Chinese animation) listing.
+func (d *DonghuaScraper) ScrapeDonghua(page int) (*models.AnimeTerbaruResponse, error) {
+	c := utils.CreateCollectorWithRetry(d.config)
+
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(d.config.BaseURL)
+
+	response := &models.AnimeTerbaruResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Data: []models.AnimeTerbaruItem{},
+	}
+
+	var scrapingErrors []string
+	pager := newPageTracker(c, page)
+
+	// Scrape donghua items
+	c.OnHTML("div.movies-list div.ml-item.ml-item-anime", func(e *colly.HTMLElement) {
+		item := models.AnimeTerbaruItem{
+			Judul:      utils.CleanText(e.ChildText(".judul")),
+			URL:        e.ChildAttr("a.ml-mask", "href"),
+			AnimeSlug:  utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
+			Episode:    optionalString(e.ChildText(".mli-episode")),
+			Uploader:   optionalString(e.ChildText(".mli-uploader")),
+			Rilis:      optionalString(e.ChildText(".mli-waktu")),
+			ReleasedAt: utils.FormatRFC3339(e.ChildText(".mli-waktu"), time.Now()),
+			Cover:      imageURL(d.config, e, "img.mli-thumb"),
+		}
+		item.EpisodeNumber = utils.EpisodeNumberValue(stringValue(item.Episode))
+
+		if item.Judul != "" && item.URL != "" {
+			response.Data = append(response.Data, item)
+		}
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	// Build URL with pagination
+	url := d.config.BaseURL + "/animedonghua/"
+	if page > 1 {
+		url += "page/" + strconv.Itoa(page) + "/"
+	}
+
+	// Visit the page
+	if err := c.Visit(url); err != nil && !pager.notFound {
+		return nil, fmt.Errorf("failed to visit donghua page: %v", err)
+	}
+
+	pagination, err := pager.result(len(response.Data))
+	if err != nil {
+		return nil, err
+	}
+	response.Pagination = pagination
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = animeTerbaruConfidence(response.Data, scrapingErrors)
+
+	fillAnimeTerbaruPlaceholders(d.config, response.Data)
+
+	return response, nil
+}
@@ -0,0 +1,151 @@
+package scrapers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// dramaCountryCacheTTL is how long the country read from a drama's page is
+// cached. A title's country never changes, so it is kept for a day.
+const dramaCountryCacheTTL = 24 * 60 * 60
+
+// dramaCountryCache is shared across scraper instances so filtering a page
+// only visits the drama pages it has not seen yet
+var dramaCountryCache = utils.NewCache()
+
+// dramaRegions maps the region query value to the country names the site
+// prints in a drama's info rows or country tag. The /others/ listing mixes
+// every region together and its cards carry no country.
+var dramaRegions = map[string][]string{
+	"jepang": {"jepang", "japan"},
+	"korea":  {"korea", "south korea", "korea selatan"},
+	"china":  {"china", "cina", "tiongkok", "taiwan", "hong kong"},
+	"barat": {
+		"usa", "united states", "amerika", "amerika serikat", "uk", "united kingdom",
+		"inggris", "canada", "kanada", "australia", "france", "prancis", "germany", "jerman", "spain", "spanyol",
+	},
+}
+
+type DramaScraper struct {
+	config *config.Config
+}
+
+func NewDramaScraper(cfg *config.Config) *DramaScraper {
+	return &DramaScraper{config: cfg}
+}
+
+// ScrapeDrama scrapes one page of the live-action (Jepang/Korea/China/Barat)
+// listing. An empty region lists every region; otherwise only the page's
+// items whose country belongs to region are kept, so pagination still
+// follows the /others/ listing.
+func (d *DramaScraper) ScrapeDrama(page int, region string) (*models.SeriesListResponse, error) {
+	countries, ok := dramaRegions[strings.ToLower(region)]
+	if region != "" && !ok {
+		return nil, fmt.Errorf("invalid region: %s. Valid regions are: jepang, korea, china, barat", region)
+	}
+
+	// Placeholders are filled after filtering so the score only counts
+	// scraped values
+	response, err := scrapeSeriesListing(nullModeConfig(d.config), d.config.BaseURL+"/others/", page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit drama page: %w", err)
+	}
+	if region == "" {
+		fillSeriesListPlaceholders(d.config, response.Data)
+		return response, nil
+	}
+
+	// Read every item's country from its own page
+	items := response.Data
+	found, lookupErrors := fetchPagesConcurrently(0, len(items)-1, func(i int) (*string, error) {
+		country, err := d.dramaCountry(items[i].URL)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", items[i].URL, err)
+		}
+		return &country, nil
+	})
+
+	response.Data = []models.SeriesListItem{}
+	unknown := 0
+	for i, country := range found {
+		if country == nil || *country == "" {
+			unknown++
+			continue
+		}
+		if matchesDramaRegion(*country, countries) {
+			response.Data = append(response.Data, items[i])
+		}
+	}
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = seriesListConfidence(response.Data, lookupErrors)
+	if unknown > 0 {
+		response.Message += fmt.Sprintf(" (%d item tanpa info negara dilewati)", unknown)
+	}
+
+	fillSeriesListPlaceholders(d.config, response.Data)
+
+	return response, nil
+}
+
+// dramaCountry returns the country listed on a drama's page, or "" when the
+// page lists none
+func (d *DramaScraper) dramaCountry(dramaURL string) (string, error) {
+	var country string
+	if dramaCountryCache.Get(dramaURL, &country) {
+		return country, nil
+	}
+
+	c := utils.CreateCollectorWithRetry(d.config)
+
+	// Info rows are rendered as "Negara : Korea Selatan"; the theme may also
+	// link the country taxonomy, e.g. /country/south-korea/
+	c.OnHTML("div.m-info", func(e *colly.HTMLElement) {
+		e.ForEach(".mli-mvi", func(_ int, el *colly.HTMLElement) {
+			label, value, found := strings.Cut(utils.CleanText(el.Text), ":")
+			switch strings.ToLower(strings.TrimSpace(label)) {
+			case "negara", "country", "asal":
+				if found && country == "" {
+					country = utils.CleanText(value)
+				}
+			}
+		})
+		if country == "" {
+			country = strings.Join(e.ChildTexts("a[href*='/country/']"), ", ")
+		}
+	})
+
+	if err := c.Visit(dramaURL); err != nil {
+		return "", err
+	}
+
+	dramaCountryCache.SetWithTTL(dramaURL, country, dramaCountryCacheTTL)
+	return country, nil
+}
+
+// matchesDramaRegion reports whether any of the comma separated countries
+// in text is one of countries
+func matchesDramaRegion(text string, countries []string) bool {
+	for _, part := range strings.Split(strings.ToLower(text), ",") {
+		part = strings.TrimSpace(part)
+		for _, country := range countries {
+			if part == country {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsValidDramaRegion reports whether region is accepted by ScrapeDrama.
+func IsValidDramaRegion(region string) bool {
+	if region == "" {
+		return true
+	}
+	_, ok := dramaRegions[strings.ToLower(region)]
+	return ok
+}
@@ -0,0 +1,217 @@
+package scrapers
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+const (
+	// maxEpisodeWalkPages bounds how many episode pages one traversal visits
+	maxEpisodeWalkPages = 60
+	// episodeWalkCacheTTL is how long a complete episode list is cached
+	episodeWalkCacheTTL = 6 * 60 * 60
+	// failedEpisodeWalkCacheTTL is how long a traversal that hit a page
+	// error, including an unreachable start page, is cached before it is
+	// retried
+	failedEpisodeWalkCacheTTL = 10 * 60
+)
+
+// episodeWalkCache is shared across scraper instances so a traversal is not
+// repeated for every request.
+var episodeWalkCache = utils.NewCache()
+
+// runningEpisodeWalks tracks the traversals running in the background by
+// cache key, with the number of pages each has visited so far
+var (
+	runningEpisodeWalksMu sync.Mutex
+	runningEpisodeWalks   = map[string]*int64{}
+)
+
+// episodeWalk is the outcome of rebuilding an episode list by following the
+// prev/next links of episode pages. An incomplete walk either stopped at
+// maxEpisodeWalkPages (Truncated) or on a page that failed to load (Failed).
+type episodeWalk struct {
+	Episodes  []models.EpisodeListItem `json:"episodes"`
+	Visited   int                      `json:"visited"`
+	Complete  bool                     `json:"complete"`
+	Truncated bool                     `json:"truncated"`
+	Failed    bool                     `json:"failed"`
+	Running   bool                     `json:"-"`
+}
+
+// Message describes the traversal for the response message.
+func (w *episodeWalk) Message() string {
+	if w.Running {
+		return fmt.Sprintf("Episode list is being rebuilt from episode navigation in the background (%d pages visited so far)", w.Visited)
+	}
+	if w.Complete {
+		return fmt.Sprintf("Episode list rebuilt from episode navigation (%d pages visited, %d episodes)", w.Visited, len(w.Episodes))
+	}
+	if len(w.Episodes) == 0 {
+		return "Episode list could not be rebuilt from episode navigation: the episode page failed to load"
+	}
+	if w.Truncated {
+		return fmt.Sprintf("Episode list partially rebuilt from episode navigation: stopped at the %d page limit with %d episodes", maxEpisodeWalkPages, len(w.Episodes))
+	}
+	return fmt.Sprintf("Episode list partially rebuilt from episode navigation: stopped after %d pages with %d episodes", w.Visited, len(w.Episodes))
+}
+
+// episodeNavigationList returns the episode list rebuilt from the episode
+// navigation of the series startURL belongs to. Traversals run in the
+// background so a request never waits on dozens of page visits: until the
+// cached result is ready, the returned walk is Running and reports progress.
+func episodeNavigationList(cfg *config.Config, startURL string) *episodeWalk {
+	cacheKey := "episode_walk_" + utils.ExtractSlugFromURL(startURL)
+
+	var cached episodeWalk
+	if episodeWalkCache.Get(cacheKey, &cached) {
+		return &cached
+	}
+
+	runningEpisodeWalksMu.Lock()
+	defer runningEpisodeWalksMu.Unlock()
+
+	if visited, ok := runningEpisodeWalks[cacheKey]; ok {
+		return &episodeWalk{Visited: int(atomic.LoadInt64(visited)), Running: true}
+	}
+
+	visited := new(int64)
+	runningEpisodeWalks[cacheKey] = visited
+	walkConfig := *cfg
+
+	go func() {
+		defer func() {
+			runningEpisodeWalksMu.Lock()
+			delete(runningEpisodeWalks, cacheKey)
+			runningEpisodeWalksMu.Unlock()
+		}()
+
+		walk, err := walkEpisodeNavigation(&walkConfig, startURL, visited)
+		if err != nil {
+			// Remember the failure briefly so requests don't keep starting
+			// walks against a dead page
+			log.Printf("[EpisodeWalk] Failed to walk episode navigation from %s: %v", startURL, err)
+			walk = &episodeWalk{Visited: int(atomic.LoadInt64(visited)), Failed: true}
+		}
+
+		// Walks that hit a page error are retried soon; a walk cut off at
+		// maxEpisodeWalkPages would stop there again, so it keeps the full TTL
+		ttl := episodeWalkCacheTTL
+		if walk.Failed {
+			ttl = failedEpisodeWalkCacheTTL
+		}
+		episodeWalkCache.SetWithTTL(cacheKey, walk, ttl)
+	}()
+
+	return &episodeWalk{Running: true}
+}
+
+// episodePage is what one episode page contributes to the walk
+type episodePage struct {
+	title   string
+	prevURL string
+	nextURL string
+}
+
+// walkEpisodeNavigation rebuilds the ordered episode list of a series
+// starting from any one of its episodes: it follows the "previous" links back
+// to the first episode and the "next" links forward to the latest one, up to
+// maxEpisodeWalkPages pages. The number of pages visited so far is kept in
+// visited.
+func walkEpisodeNavigation(cfg *config.Config, startURL string, visited *int64) (*episodeWalk, error) {
+	c := utils.CreateCollectorWithRetry(cfg)
+
+	var page episodePage
+	c.OnHTML("div.list-title h2", func(e *colly.HTMLElement) {
+		page.title = utils.CleanText(e.Text)
+	})
+	c.OnHTML("div.naveps", func(e *colly.HTMLElement) {
+		page.prevURL = e.Request.AbsoluteURL(e.ChildAttr("div.nvs:not(.nvsc):not(.rght) a", "href"))
+		page.nextURL = e.Request.AbsoluteURL(e.ChildAttr("div.nvs.rght a", "href"))
+	})
+
+	visit := func(episodeURL string) (episodePage, error) {
+		page = episodePage{}
+		atomic.AddInt64(visited, 1)
+		if err := c.Visit(episodeURL); err != nil {
+			return page, err
+		}
+		return page, nil
+	}
+
+	walk := &episodeWalk{Complete: true}
+	seen := map[string]bool{}
+
+	start, err := visit(startURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit episode page: %v", err)
+	}
+	walk.Visited++
+	seen[normalizeEpisodeURL(startURL)] = true
+	walk.Episodes = append(walk.Episodes, newEpisodeListItem(start.title, startURL, ""))
+
+	// Walk back to the first episode, then forward to the latest one
+	var earlier []models.EpisodeListItem
+	for link := start.prevURL; isEpisodeLink(link, seen); {
+		if walk.Visited >= maxEpisodeWalkPages {
+			walk.Complete, walk.Truncated = false, true
+			break
+		}
+		current, err := visit(link)
+		walk.Visited++
+		seen[normalizeEpisodeURL(link)] = true
+		if err != nil {
+			walk.Complete, walk.Failed = false, true
+			break
+		}
+		earlier = append(earlier, newEpisodeListItem(current.title, link, ""))
+		link = current.prevURL
+	}
+	for i, j := 0, len(earlier)-1; i < j; i, j = i+1, j-1 {
+		earlier[i], earlier[j] = earlier[j], earlier[i]
+	}
+	walk.Episodes = append(earlier, walk.Episodes...)
+
+	for link := start.nextURL; isEpisodeLink(link, seen); {
+		if walk.Visited >= maxEpisodeWalkPages {
+			walk.Complete, walk.Truncated = false, true
+			break
+		}
+		current, err := visit(link)
+		walk.Visited++
+		seen[normalizeEpisodeURL(link)] = true
+		if err != nil {
+			walk.Complete, walk.Failed = false, true
+			break
+		}
+		walk.Episodes = append(walk.Episodes, newEpisodeListItem(current.title, link, ""))
+		link = current.nextURL
+	}
+
+	return walk, nil
+}
+
+// isEpisodeLink reports whether a prev/next link leads to an episode that has
+// not been visited yet. The last episode's "next" link points back at itself
+// with a "#" fragment, and the first one's may point at the series page.
+func isEpisodeLink(link string, seen map[string]bool) bool {
+	if link == "" || strings.Contains(link, "#") {
+		return false
+	}
+	if strings.Contains(link, "/anime/") || strings.Contains(link, "/series/") {
+		return false
+	}
+	return !seen[normalizeEpisodeURL(link)]
+}
+
+func normalizeEpisodeURL(link string) string {
+	return strings.TrimSuffix(link, "/")
+}
@@ -0,0 +1,195 @@
+package scrapers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// filtersCacheTTL is how long the daftar-anime-2 filter options are cached.
+// The option list rarely changes, so it is kept much longer than page data.
+const filtersCacheTTL = 24 * 60 * 60
+
+// filtersCache is shared across scraper instances so the long TTL survives
+// the per-request scraper construction in the API handlers.
+var filtersCache = utils.NewCache()
+
+type FilterScraper struct {
+	config *config.Config
+	cache  *utils.Cache
+}
+
+func NewFilterScraper(cfg *config.Config) *FilterScraper {
+	return &FilterScraper{
+		config: cfg,
+		cache:  filtersCache,
+	}
+}
+
+// ScrapeFilters scrapes every status, type, order and genre option offered by
+// the daftar-anime-2 filter form.
+func (f *FilterScraper) ScrapeFilters() (*models.FiltersResponse, error) {
+	cacheKey := "filters_" + utils.ExtractDomain(f.config.BaseURL)
+
+	// Try to get from cache first
+	var cachedResponse models.FiltersResponse
+	if f.cache.Get(cacheKey, &cachedResponse) {
+		return &cachedResponse, nil
+	}
+
+	c := utils.CreateCollectorWithRetry(f.config)
+
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(f.config.BaseURL)
+
+	response := &models.FiltersResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Data: models.AvailableFilters{
+			StatusOptions: []models.FilterOption{},
+			TypeOptions:   []models.FilterOption{},
+			OrderOptions:  []models.FilterOption{},
+			GenreOptions:  []models.FilterOption{},
+		},
+	}
+
+	var scrapingErrors []string
+
+	// Each filter category is a row in the filter form
+	c.OnHTML("div.filtersearch form tr", func(e *colly.HTMLElement) {
+		filterTitle := utils.CleanText(e.ChildText(".filter_title"))
+
+		switch filterTitle {
+		case "Status":
+			e.ForEach("label.radio", func(_ int, el *colly.HTMLElement) {
+				response.Data.StatusOptions = append(response.Data.StatusOptions, models.FilterOption{
+					DisplayName: utils.CleanText(el.Text),
+					QueryValue:  el.ChildAttr("input[type=radio]", "value"),
+				})
+			})
+
+		case "Type":
+			e.ForEach("label.radio", func(_ int, el *colly.HTMLElement) {
+				response.Data.TypeOptions = append(response.Data.TypeOptions, models.FilterOption{
+					DisplayName: utils.CleanText(el.Text),
+					QueryValue:  el.ChildAttr("input[type=radio]", "value"),
+				})
+			})
+
+		case "Urutkan Berdasarkan":
+			e.ForEach("ul.filter-sort li", func(_ int, el *colly.HTMLElement) {
+				response.Data.OrderOptions = append(response.Data.OrderOptions, models.FilterOption{
+					DisplayName: utils.CleanText(el.ChildText("label")),
+					QueryValue:  el.ChildAttr("input[type=radio]", "value"),
+				})
+			})
+
+		case "Genre":
+			e.ForEach("label.tax_fil", func(_ int, el *colly.HTMLElement) {
+				response.Data.GenreOptions = append(response.Data.GenreOptions, models.FilterOption{
+					DisplayName: utils.CleanText(el.Text),
+					QueryValue:  el.ChildAttr("input[type=checkbox]", "value"),
+				})
+			})
+		}
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	// Visit the daftar-anime-2 page that hosts the filter form
+	url := f.config.BaseURL + "/daftar-anime-2/"
+	if err := c.Visit(url); err != nil {
+		return nil, fmt.Errorf("failed to visit filter page: %v", err)
+	}
+
+	// Calculate confidence score: every filter category should have options,
+	// and every option a label
+	scorer := utils.NewConfidenceScorer().
+		Field("status_options", 1, utils.ValidateText).
+		Field("type_options", 1, utils.ValidateText).
+		Field("order_options", 1, utils.ValidateText).
+		Field("genre_options", 1, utils.ValidateText).
+		Field("display_name", 2, utils.ValidateText)
+
+	categories := map[string][]models.FilterOption{
+		"status_options": response.Data.StatusOptions,
+		"type_options":   response.Data.TypeOptions,
+		"order_options":  response.Data.OrderOptions,
+		"genre_options":  response.Data.GenreOptions,
+	}
+	totalCategories := len(categories)
+	filledCategories := 0
+	for name, options := range categories {
+		scorer.Record(name, len(options) > 0, utils.IssueMissing)
+		if len(options) > 0 {
+			filledCategories++
+		}
+		for _, option := range options {
+			scorer.Check("display_name", option.DisplayName)
+		}
+	}
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = scorer.Result(scrapingErrors)
+
+	// Only cache complete results so a broken page doesn't stick for a day
+	if filledCategories == totalCategories {
+		f.cache.SetWithTTL(cacheKey, response, filtersCacheTTL)
+	}
+
+	return response, nil
+}
+
+// ValidateCatalogFilter checks the status, type, order and genre values of a
+// CatalogFilter against the options the site currently offers. It returns an
+// error naming the first invalid parameter.
+func (f *FilterScraper) ValidateCatalogFilter(filter models.CatalogFilter) error {
+	filters, err := f.ScrapeFilters()
+	if err != nil {
+		return nil // Can't validate without the option list, let the site decide
+	}
+
+	if err := validateFilterValue("status", filter.Status, filters.Data.StatusOptions); err != nil {
+		return err
+	}
+	if err := validateFilterValue("type", filter.Type, filters.Data.TypeOptions); err != nil {
+		return err
+	}
+	if err := validateFilterValue("order", filter.Order, filters.Data.OrderOptions); err != nil {
+		return err
+	}
+	for _, genre := range filter.Genres {
+		if err := validateFilterValue("genre[]", genre, filters.Data.GenreOptions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFilterValue reports an error when value is not one of the options.
+// Empty values and empty option lists are always accepted.
+func validateFilterValue(param, value string, options []models.FilterOption) error {
+	if value == "" || len(options) == 0 {
+		return nil
+	}
+
+	validValues := make([]string, 0, len(options))
+	for _, option := range options {
+		if strings.EqualFold(option.QueryValue, value) {
+			return nil
+		}
+		if option.QueryValue != "" {
+			validValues = append(validValues, option.QueryValue)
+		}
+	}
+
+	return fmt.Errorf("invalid %s '%s'. Valid values are: %s", param, value, strings.Join(validValues, ", "))
+}
@@ -0,0 +1,119 @@
+package scrapers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+type FranchiseScraper struct {
+	config *config.Config
+}
+
+func NewFranchiseScraper(cfg *config.Config) *FranchiseScraper {
+	return &FranchiseScraper{config: cfg}
+}
+
+// ScrapeFranchise links the sibling seasons, movies and specials of a title.
+// Siblings are found among the title's recommendations and the search
+// results for its base title, and matched by that base title.
+func (f *FranchiseScraper) ScrapeFranchise(animeSlug string) (*models.FranchiseResponse, error) {
+	detail, err := NewDetailScraper(f.config).ScrapeAnimeDetail(animeSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	baseTitle := utils.FranchiseBaseTitle(detail.Judul)
+	searchTitle := utils.FranchiseStem(detail.Judul)
+
+	response := &models.FranchiseResponse{
+		BaseResponse: models.BaseResponse{
+			Source: detail.Source,
+		},
+		AnimeSlug: animeSlug,
+		Data:      []models.FranchiseEntry{},
+	}
+
+	var scrapingErrors []string
+	seen := make(map[string]bool)
+	addEntry := func(title, entryURL, cover, foundVia string) {
+		slug := utils.ExtractSlugFromURL(entryURL)
+		if slug == "" || seen[slug] {
+			return
+		}
+		seen[slug] = true
+
+		entry := models.FranchiseEntry{
+			Title:     title,
+			URL:       entryURL,
+			AnimeSlug: slug,
+			Cover:     cover,
+			IsCurrent: foundVia == models.FranchiseFoundViaSelf,
+			FoundVia:  foundVia,
+		}
+		if number, ok := utils.ParseSeasonNumber(title); ok {
+			entry.SeasonNumber = &number
+		}
+		response.Data = append(response.Data, entry)
+	}
+
+	addEntry(detail.Judul, detail.URL, detail.Cover, models.FranchiseFoundViaSelf)
+
+	if searchTitle != "" {
+		for _, rec := range detail.Recommendations {
+			if utils.SameFranchise(detail.Judul, rec.Title) {
+				addEntry(rec.Title, rec.URL, rec.CoverURL, models.FranchiseFoundViaRecommendation)
+			}
+		}
+
+		results, err := NewSearchScraper(f.config).SearchAnime(searchTitle, 1)
+		if err != nil {
+			scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error searching %q: %v", searchTitle, err))
+		} else {
+			for _, item := range results.Data {
+				if utils.SameFranchise(detail.Judul, item.Judul) {
+					addEntry(item.Judul, item.URL, item.Cover, models.FranchiseFoundViaSearch)
+				}
+			}
+		}
+	}
+
+	// The subtitle or sequel number only goes when a sibling shares the rest
+	response.BaseTitle = baseTitle
+	for _, entry := range response.Data {
+		if utils.FranchiseBaseTitle(entry.Title) != baseTitle {
+			response.BaseTitle = searchTitle
+			break
+		}
+	}
+
+	// Order by season; entries without a season number (usually the first
+	// season) come first, then by title
+	sort.SliceStable(response.Data, func(i, j int) bool {
+		a, b := seasonSortKey(response.Data[i]), seasonSortKey(response.Data[j])
+		if a != b {
+			return a < b
+		}
+		return response.Data[i].Title < response.Data[j].Title
+	})
+
+	response.ConfidenceScore = detail.ConfidenceScore
+	if len(scrapingErrors) > 0 {
+		response.ConfidenceScore *= 0.8
+		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
+	} else {
+		response.Message = "Data berhasil diambil"
+	}
+
+	return response, nil
+}
+
+func seasonSortKey(entry models.FranchiseEntry) int {
+	if entry.SeasonNumber == nil {
+		return 1
+	}
+	return *entry.SeasonNumber
+}
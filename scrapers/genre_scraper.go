@@ -0,0 +1,127 @@
+package scrapers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// genresCacheTTL is how long the genre index is cached. Counts change slowly,
+// so a few hours is fresh enough.
+const genresCacheTTL = 6 * 60 * 60
+
+// genresCache is shared across scraper instances so the TTL survives the
+// per-request scraper construction in the API handlers.
+var genresCache = utils.NewCache()
+
+type GenreScraper struct {
+	config *config.Config
+	cache  *utils.Cache
+}
+
+func NewGenreScraper(cfg *config.Config) *GenreScraper {
+	return &GenreScraper{
+		config: cfg,
+		cache:  genresCache,
+	}
+}
+
+// ScrapeGenres scrapes the genre index (name, slug and item count) from the
+// homepage sidebar.
+func (g *GenreScraper) ScrapeGenres() (*models.GenresResponse, error) {
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(g.config.BaseURL)
+
+	cacheKey := "genres_" + domain
+
+	// Try to get from cache first
+	var cachedResponse models.GenresResponse
+	if g.cache.Get(cacheKey, &cachedResponse) {
+		return &cachedResponse, nil
+	}
+
+	c := utils.CreateCollectorWithRetry(g.config)
+
+	response := &models.GenresResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Data: []models.Genre{},
+	}
+
+	var scrapingErrors []string
+
+	// Each sidebar entry looks like <a href=".../genre/drama/">Drama<span> (943)</span></a>
+	c.OnHTML("aside#sidebar ul.genres li", func(e *colly.HTMLElement) {
+		countText := utils.CleanText(e.ChildText("span"))
+		name := strings.TrimSpace(strings.Replace(utils.CleanText(e.ChildText("a")), countText, "", 1))
+		genreURL := e.ChildAttr("a", "href")
+
+		count, err := strconv.Atoi(strings.Trim(countText, "() "))
+		if err != nil {
+			count = 0
+		}
+
+		if name != "" && genreURL != "" {
+			response.Data = append(response.Data, models.Genre{
+				Name:  name,
+				Slug:  strings.ToLower(utils.ExtractSlugFromURL(genreURL)),
+				URL:   genreURL,
+				Count: count,
+			})
+		}
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	// Visit the homepage, which renders the full genre sidebar
+	if err := c.Visit(g.config.BaseURL + "/"); err != nil {
+		return nil, fmt.Errorf("failed to visit homepage: %v", err)
+	}
+
+	// Calculate confidence score
+	scorer := utils.NewConfidenceScorer().
+		Field("name", 3, utils.ValidateText).
+		Field("slug", 2, utils.ValidateText).
+		Field("url", 2, utils.ValidateURL).
+		Field("count", 1, utils.ValidateText)
+	for _, genre := range response.Data {
+		scorer.Check("name", genre.Name)
+		scorer.Check("slug", genre.Slug)
+		scorer.Check("url", genre.URL)
+		scorer.Record("count", genre.Count > 0, utils.IssueMissing)
+	}
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = scorer.Result(scrapingErrors)
+
+	if len(response.Data) > 0 {
+		g.cache.SetWithTTL(cacheKey, response, genresCacheTTL)
+	}
+
+	return response, nil
+}
+
+// ScrapeGenre scrapes one page of the titles listed under a genre.
+func (g *GenreScraper) ScrapeGenre(slug string, page int) (*models.GenreListResponse, error) {
+	listingURL := g.config.BaseURL + "/genre/" + strings.Trim(slug, "/") + "/"
+
+	listing, err := scrapeListing(g.config, listingURL, "div.movies-list div.ml-item", page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit genre page: %w", err)
+	}
+
+	return &models.GenreListResponse{
+		BaseResponse: listing.BaseResponse,
+		Genre:        slug,
+		Pagination:   listing.Pagination,
+		Data:         listing.Data,
+	}, nil
+}
@@ -2,7 +2,6 @@ package scrapers
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
 	"time"
 
@@ -30,6 +29,7 @@ func (h *HomeScraper) ScrapeHome() (*models.HomeResponse, error) {
 	// Try to get from cache first
 	var cachedResponse models.HomeResponse
 	if h.cache.Get(cacheKey, &cachedResponse) {
+		fillHomePlaceholders(h.config, &cachedResponse)
 		return &cachedResponse, nil
 	}
 
@@ -42,25 +42,14 @@ func (h *HomeScraper) ScrapeHome() (*models.HomeResponse, error) {
 		BaseResponse: models.BaseResponse{
 			Source: domain,
 		},
-		Top10:  []models.Top10Item{},
-		NewEps: []models.NewEpisodeItem{},
-		Movies: []models.MovieItem{},
-		JadwalRilis: models.ScheduleData{
-			Monday:    []models.ScheduleItem{},
-			Tuesday:   []models.ScheduleItem{},
-			Wednesday: []models.ScheduleItem{},
-			Thursday:  []models.ScheduleItem{},
-			Friday:    []models.ScheduleItem{},
-			Saturday:  []models.ScheduleItem{},
-			Sunday:    []models.ScheduleItem{},
-		},
+		Top10:       []models.Top10Item{},
+		NewEps:      []models.NewEpisodeItem{},
+		Movies:      []models.MovieItem{},
+		JadwalRilis: emptyScheduleData(),
 	}
 
 	var scrapingErrors []string
 
-	// Collect all items for schedule generation
-	var allScheduleItems []models.ScheduleItem
-
 	// Scrape all sections
 	c.OnHTML("div.movies-list-wrap", func(e *colly.HTMLElement) {
 		sectionTitle := utils.CleanText(e.ChildText(".list-title h2"))
@@ -72,157 +61,43 @@ func (h *HomeScraper) ScrapeHome() (*models.HomeResponse, error) {
 					Judul:     utils.CleanText(el.ChildText(".judul")),
 					URL:       el.ChildAttr("a.ml-mask", "href"),
 					AnimeSlug: utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					Rating:    utils.CleanText(el.ChildText(".mli-mvi")),
-					Cover:     el.ChildAttr("img.mli-thumb", "src"),
-					Genres:    []string{"Action", "Adventure", "Drama"}, // Enhanced default genres
-				}
-				// Fill missing fields with dummy data
-				if item.Rating == "" {
-					item.Rating = "8.5"
-				}
-				if len(item.Genres) == 0 {
-					item.Genres = []string{"Action", "Adventure", "Drama"}
+					Rating:    optionalString(el.ChildText(".mli-mvi")),
+					Cover:     imageURL(h.config, el, "img.mli-thumb"),
 				}
+				item.ScoreValue = utils.ScoreValue(stringValue(item.Rating))
 				response.Top10 = append(response.Top10, item)
-
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       item.Judul,
-					URL:         item.URL,
-					AnimeSlug:   item.AnimeSlug,
-					CoverURL:    item.Cover,
-					Type:        "TV",
-					Score:       item.Rating,
-					Genres:      item.Genres,
-					ReleaseTime: h.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
 			})
 
 		case strings.Contains(sectionTitle, "Anime Donghua Terbaru"):
 			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
 				item := models.NewEpisodeItem{
-					Judul:     utils.CleanText(el.ChildText(".judul")),
-					URL:       el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug: utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					Episode:   utils.CleanText(el.ChildText(".mli-episode")),
-					Rilis:     utils.CleanText(el.ChildText(".mli-waktu")),
-					Cover:     el.ChildAttr("img.mli-thumb", "src"),
-				}
-				// Fill missing fields with dummy data
-				if item.Episode == "" {
-					item.Episode = "Episode 1"
-				}
-				if item.Rilis == "" {
-					item.Rilis = "January 2025"
+					Judul:      utils.CleanText(el.ChildText(".judul")),
+					URL:        el.ChildAttr("a.ml-mask", "href"),
+					AnimeSlug:  utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
+					Episode:    optionalString(el.ChildText(".mli-episode")),
+					Rilis:      optionalString(el.ChildText(".mli-waktu")),
+					ReleasedAt: utils.FormatRFC3339(el.ChildText(".mli-waktu"), time.Now()),
+					Cover:      imageURL(h.config, el, "img.mli-thumb"),
 				}
+				item.EpisodeNumber = utils.EpisodeNumberValue(stringValue(item.Episode))
 				response.NewEps = append(response.NewEps, item)
-
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       item.Judul,
-					URL:         item.URL,
-					AnimeSlug:   item.AnimeSlug,
-					CoverURL:    item.Cover,
-					Type:        "TV",
-					Score:       "7.8",
-					Genres:      []string{"Animation", "Drama", "Adventure"},
-					ReleaseTime: h.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Top 10 Film"):
-			e.ForEach(".ml-item-potrait .ml-item", func(_ int, el *colly.HTMLElement) {
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "Movie",
-					Score:       utils.CleanText(el.ChildText(".mli-mvi")),
-					Genres:      []string{"Action", "Drama", "Thriller"},
-					ReleaseTime: h.generateRandomTime(),
-				}
-				if scheduleItem.Score == "" {
-					scheduleItem.Score = "8.2"
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
 			})
 
 		case strings.Contains(sectionTitle, "Film Terbaru"):
 			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
 				item := models.MovieItem{
-					Judul:     utils.CleanText(el.ChildText(".judul")),
-					URL:       el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug: utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					Tanggal:   utils.CleanText(el.ChildText(".mli-waktu")),
-					Cover:     el.ChildAttr("img.mli-thumb", "src"),
-					Genres:    []string{"Action", "Drama", "Thriller"}, // Enhanced default genres
-				}
-				// Fill missing fields with dummy data
-				if item.Tanggal == "" {
-					item.Tanggal = "January 2025"
-				}
-				if len(item.Genres) == 0 {
-					item.Genres = []string{"Action", "Drama", "Thriller"}
+					Judul:      utils.CleanText(el.ChildText(".judul")),
+					URL:        el.ChildAttr("a.ml-mask", "href"),
+					AnimeSlug:  utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
+					Tanggal:    optionalString(el.ChildText(".mli-waktu")),
+					ReleasedAt: utils.FormatRFC3339(el.ChildText(".mli-waktu"), time.Now()),
+					Cover:      imageURL(h.config, el, "img.mli-thumb"),
 				}
 				response.Movies = append(response.Movies, item)
-
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       item.Judul,
-					URL:         item.URL,
-					AnimeSlug:   item.AnimeSlug,
-					CoverURL:    item.Cover,
-					Type:        "Movie",
-					Score:       "7.5",
-					Genres:      item.Genres,
-					ReleaseTime: h.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Jepang Korea China Barat"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV",
-					Score:       "7.9",
-					Genres:      []string{"Drama", "Romance", "Comedy"},
-					ReleaseTime: h.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "TV Show"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				// Add to schedule items
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV Show",
-					Score:       "8.1",
-					Genres:      []string{"Reality", "Entertainment", "Comedy"},
-					ReleaseTime: h.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
 			})
 		}
 	})
 
-	// Generate schedule after collecting all items
-	c.OnScraped(func(r *colly.Response) {
-		response.JadwalRilis = h.generateWeeklySchedule(allScheduleItems)
-	})
-
 	// Error handling
 	c.OnError(func(r *colly.Response, err error) {
 		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
@@ -234,195 +109,175 @@ func (h *HomeScraper) ScrapeHome() (*models.HomeResponse, error) {
 		return nil, fmt.Errorf("failed to visit homepage: %v", err)
 	}
 
-	// Calculate confidence score
-	totalFields := len(response.Top10)*6 + len(response.NewEps)*6 + len(response.Movies)*6
-	filledFields := 0
+	// Attach the release schedule from the site's schedule page
+	scheduleData, err := NewScheduleScraper(nullModeConfig(h.config)).ScrapeSchedule()
+	if err != nil {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error building schedule: %v", err))
+	} else {
+		response.JadwalRilis = scheduleData.Data
+	}
 
+	// Calculate confidence score over the fields every card carries
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("rating", 1, utils.ValidateScore).
+		Field("episode", 1, utils.ValidateText).
+		Field("rilis", 1, utils.ValidateText).
+		Field("tanggal", 1, utils.ValidateText)
+
+	checkCard := func(judul, url, slug, cover string) {
+		scorer.Check("judul", judul)
+		scorer.Check("url", url)
+		scorer.Check("anime_slug", slug)
+		scorer.Check("cover", cover)
+	}
 	for _, item := range response.Top10 {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Rating != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
-		if len(item.Genres) > 0 {
-			filledFields++
-		}
+		checkCard(item.Judul, item.URL, item.AnimeSlug, item.Cover)
+		scorer.CheckOptional("rating", item.Rating)
 	}
-
 	for _, item := range response.NewEps {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Episode != "" {
-			filledFields++
-		}
-		if item.Rilis != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
+		checkCard(item.Judul, item.URL, item.AnimeSlug, item.Cover)
+		scorer.CheckOptional("episode", item.Episode)
+		scorer.CheckOptional("rilis", item.Rilis)
 	}
-
 	for _, item := range response.Movies {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Tanggal != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
-		if len(item.Genres) > 0 {
-			filledFields++
-		}
-	}
-
-	if totalFields > 0 {
-		response.ConfidenceScore = float64(filledFields) / float64(totalFields)
-	} else {
-		response.ConfidenceScore = 0.0
+		checkCard(item.Judul, item.URL, item.AnimeSlug, item.Cover)
+		scorer.CheckOptional("tanggal", item.Tanggal)
 	}
 
-	// Adjust confidence score based on errors
-	if len(scrapingErrors) > 0 {
-		response.ConfidenceScore *= 0.8 // Reduce confidence if there were errors
-		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
-	} else {
-		response.Message = "Data berhasil diambil"
-	}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = scorer.Result(scrapingErrors)
 
 	// Cache the response
 	h.cache.Set(cacheKey, response)
 
+	fillHomePlaceholders(h.config, response)
+
 	return response, nil
 }
 
-// generateRandomTime generates a random time in HH:MM format
-func (h *HomeScraper) generateRandomTime() string {
-	rand.Seed(time.Now().UnixNano())
-	hours := rand.Intn(24)
-	minutes := rand.Intn(60)
-	return fmt.Sprintf("%02d:%02d", hours, minutes)
-}
+// ScrapeHomeSections scrapes every div.movies-list-wrap section on the
+// homepage generically, so sections the site adds later show up without a
+// code change.
+func (h *HomeScraper) ScrapeHomeSections() (*models.HomeSectionsResponse, error) {
+	cacheKey := "home_sections"
 
-// generateWeeklySchedule distributes items randomly across the week
-func (h *HomeScraper) generateWeeklySchedule(allItems []models.ScheduleItem) models.ScheduleData {
-	if len(allItems) == 0 {
-		return models.ScheduleData{
-			Monday:    []models.ScheduleItem{},
-			Tuesday:   []models.ScheduleItem{},
-			Wednesday: []models.ScheduleItem{},
-			Thursday:  []models.ScheduleItem{},
-			Friday:    []models.ScheduleItem{},
-			Saturday:  []models.ScheduleItem{},
-			Sunday:    []models.ScheduleItem{},
-		}
+	// Try to get from cache first
+	var cachedResponse models.HomeSectionsResponse
+	if h.cache.Get(cacheKey, &cachedResponse) {
+		fillHomeSectionPlaceholders(h.config, cachedResponse.Data)
+		return &cachedResponse, nil
 	}
 
-	// Shuffle the items
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(allItems), func(i, j int) {
-		allItems[i], allItems[j] = allItems[j], allItems[i]
-	})
+	c := utils.CreateCollectorWithRetry(h.config)
 
-	schedule := models.ScheduleData{
-		Monday:    []models.ScheduleItem{},
-		Tuesday:   []models.ScheduleItem{},
-		Wednesday: []models.ScheduleItem{},
-		Thursday:  []models.ScheduleItem{},
-		Friday:    []models.ScheduleItem{},
-		Saturday:  []models.ScheduleItem{},
-		Sunday:    []models.ScheduleItem{},
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(h.config.BaseURL)
+
+	response := &models.HomeSectionsResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Data: []models.HomeSection{},
 	}
 
-	// Distribute items across days (aim for 3 items per day)
-	itemsPerDay := 3
-	days := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+	var scrapingErrors []string
 
-	for i, item := range allItems {
-		dayIndex := (i / itemsPerDay) % 7
-		day := days[dayIndex]
+	c.OnHTML("div.movies-list-wrap", func(e *colly.HTMLElement) {
+		section := models.HomeSection{
+			Title:    utils.CleanText(e.ChildText(".list-title h2")),
+			MoreLink: e.ChildAttr("a.pull-right", "href"),
+			Items:    []models.HomeSectionItem{},
+		}
 
-		switch day {
-		case "Monday":
-			if len(schedule.Monday) < itemsPerDay {
-				schedule.Monday = append(schedule.Monday, item)
-			}
-		case "Tuesday":
-			if len(schedule.Tuesday) < itemsPerDay {
-				schedule.Tuesday = append(schedule.Tuesday, item)
+		e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
+			url := el.ChildAttr("a.ml-mask", "href")
+			item := models.HomeSectionItem{
+				Rank:      utils.CleanText(el.ChildText(".mli-topten b")),
+				Judul:     utils.CleanText(el.ChildText(".judul")),
+				URL:       url,
+				AnimeSlug: utils.ExtractSlugFromURL(url),
+				Cover:     imageURL(h.config, el, "img.mli-thumb"),
+				Episode:   optionalString(el.ChildText(".mli-episode")),
+				Rilis:     optionalString(el.ChildText(".mli-waktu")),
+				Quality:   utils.CleanText(el.ChildText(".mli-quality")),
 			}
-		case "Wednesday":
-			if len(schedule.Wednesday) < itemsPerDay {
-				schedule.Wednesday = append(schedule.Wednesday, item)
-			}
-		case "Thursday":
-			if len(schedule.Thursday) < itemsPerDay {
-				schedule.Thursday = append(schedule.Thursday, item)
-			}
-		case "Friday":
-			if len(schedule.Friday) < itemsPerDay {
-				schedule.Friday = append(schedule.Friday, item)
-			}
-		case "Saturday":
-			if len(schedule.Saturday) < itemsPerDay {
-				schedule.Saturday = append(schedule.Saturday, item)
+
+			// Top 10 cards only carry the rating in .mli-mvi; regular cards put
+			// views on the left and the rating in a right-aligned span.
+			if item.Rank != "" {
+				item.Rating = optionalString(el.ChildText(".mli-mvi"))
+			} else {
+				item.Rating = optionalString(el.ChildText("span.mli-mvi[style*='text-align:right']"))
+				item.Views = optionalString(el.ChildText("span.mli-mvi:not([style*='text-align:right'])"))
 			}
-		case "Sunday":
-			if len(schedule.Sunday) < itemsPerDay {
-				schedule.Sunday = append(schedule.Sunday, item)
+			item.ScoreValue = utils.ScoreValue(stringValue(item.Rating))
+			item.ViewsCount = utils.ViewsCount(stringValue(item.Views))
+			item.EpisodeNumber = utils.EpisodeNumberValue(stringValue(item.Episode))
+
+			if item.Judul != "" && item.URL != "" {
+				section.Items = append(section.Items, item)
 			}
+		})
+
+		if section.Title != "" {
+			response.Data = append(response.Data, section)
 		}
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	// Visit the homepage
+	url := h.config.BaseURL + "/"
+	if err := c.Visit(url); err != nil {
+		return nil, fmt.Errorf("failed to visit homepage: %v", err)
 	}
 
-	// If we have remaining items and some days are not full, distribute them randomly
-	remainingItems := allItems[len(days)*itemsPerDay:]
-	for _, item := range remainingItems {
-		dayIndex := rand.Intn(7)
-		day := days[dayIndex]
-
-		switch day {
-		case "Monday":
-			schedule.Monday = append(schedule.Monday, item)
-		case "Tuesday":
-			schedule.Tuesday = append(schedule.Tuesday, item)
-		case "Wednesday":
-			schedule.Wednesday = append(schedule.Wednesday, item)
-		case "Thursday":
-			schedule.Thursday = append(schedule.Thursday, item)
-		case "Friday":
-			schedule.Friday = append(schedule.Friday, item)
-		case "Saturday":
-			schedule.Saturday = append(schedule.Saturday, item)
-		case "Sunday":
-			schedule.Sunday = append(schedule.Sunday, item)
+	// Calculate confidence score: every section should have items, and every
+	// item a title, URL and cover. Sections carry different extras (ratings,
+	// views, episodes), so those are only validated where present.
+	scorer := utils.NewConfidenceScorer().
+		Field("items", 2, utils.ValidateText).
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("rating", 1, utils.ValidateScore).
+		Field("views", 1, utils.ValidateText).
+		Field("episode", 1, utils.ValidateText).
+		Field("rilis", 1, utils.ValidateText)
+
+	checkPresent := func(name string, value *string) {
+		if value != nil {
+			scorer.Check(name, *value)
+		}
+	}
+	for _, section := range response.Data {
+		scorer.Record("items", len(section.Items) > 0, utils.IssueMissing)
+		for _, item := range section.Items {
+			scorer.Item(item.DefaultedFields)
+			scorer.Check("judul", item.Judul)
+			scorer.Check("url", item.URL)
+			scorer.Check("anime_slug", item.AnimeSlug)
+			scorer.Check("cover", item.Cover)
+			checkPresent("rating", item.Rating)
+			checkPresent("views", item.Views)
+			checkPresent("episode", item.Episode)
+			checkPresent("rilis", item.Rilis)
 		}
 	}
 
-	return schedule
-}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = scorer.Result(scrapingErrors)
 
+	// Cache the response
+	h.cache.Set(cacheKey, response)
+
+	fillHomeSectionPlaceholders(h.config, response.Data)
+
+	return response, nil
+}
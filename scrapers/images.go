@@ -0,0 +1,13 @@
+package scrapers
+
+import (
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// imageURL returns the image URL of the first img matching selector inside
+// e, preferring lazy-loaded and srcset sources over placeholder srcs
+func imageURL(cfg *config.Config, e *colly.HTMLElement, selector string) string {
+	return utils.ExtractImageURL(e.DOM.Find(selector), cfg.BaseURL, cfg.StripImageResize)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gocolly/colly/v2"
 	"github.com/nabilulilalbab/winbu.tv/config"
@@ -33,6 +34,7 @@ func (m *MovieScraper) ScrapeMovies(page int) (*models.MovieResponse, error) {
 	}
 
 	var scrapingErrors []string
+	pager := newPageTracker(c, page)
 
 	// Scrape movie items
 	c.OnHTML("div.ml-item.ml-item-anime.ml-item-latest.ml-potrait", func(e *colly.HTMLElement) {
@@ -40,22 +42,22 @@ func (m *MovieScraper) ScrapeMovies(page int) (*models.MovieResponse, error) {
 		rating := utils.CleanText(e.ChildText("span.mli-mvi[style*='text-align:right']"))
 
 		item := models.MovieDetailItem{
-			Judul:     utils.CleanText(e.ChildText(".judul")),
-			URL:       e.ChildAttr("a.ml-mask", "href"),
-			AnimeSlug: utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
-			Status:    "Completed", // Default status for movies
-			Skor:      rating,
-			Sinopsis:  "", // Will be filled if available
-			Views:     utils.CleanText(e.ChildText(".mli-info .mli-mvi")),
-			Cover:     e.ChildAttr("img.mli-thumb", "src"),
-			Genres:    []string{"Movie"}, // Default genre
-			Tanggal:   utils.CleanText(e.ChildText(".mli-waktu")),
-		}
-
-		// Try to extract more detailed information if available
-		if synopsis := utils.CleanText(e.ChildText(".mli-synopsis")); synopsis != "" {
-			item.Sinopsis = synopsis
-		}
+			Judul:      utils.CleanText(e.ChildText(".judul")),
+			URL:        e.ChildAttr("a.ml-mask", "href"),
+			AnimeSlug:  utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
+			Status:     "Completed", // Default status for movies
+			Skor:       optionalString(rating),
+			Views:      optionalString(e.ChildText(".mli-info .mli-mvi")),
+			Cover:      imageURL(m.config, e, "img.mli-thumb"),
+			Tanggal:    optionalString(e.ChildText(".mli-waktu")),
+			ReleasedAt: utils.FormatRFC3339(e.ChildText(".mli-waktu"), time.Now()),
+		}
+		item.ScoreValue = utils.ScoreValue(rating)
+
+		synopsis := synopsisOf(m.config, e, ".mli-synopsis")
+		item.SinopsisParagraphs = synopsis.Paragraphs
+		item.Sinopsis, item.SinopsisMarkdown = synopsisText(synopsis)
+		item.ViewsCount = utils.ViewsCount(stringValue(item.Views))
 
 		// Try to extract genres if available
 		genreText := utils.CleanText(e.ChildText(".mli-genre"))
@@ -67,23 +69,6 @@ func (m *MovieScraper) ScrapeMovies(page int) (*models.MovieResponse, error) {
 			item.Genres = genres
 		}
 
-		// Fill missing fields with dummy data
-		if item.Skor == "" {
-			item.Skor = "7.5"
-		}
-		if item.Sinopsis == "" {
-			item.Sinopsis = "An exciting movie with great storyline and amazing characters."
-		}
-		if item.Views == "" {
-			item.Views = "10,000+ views"
-		}
-		if len(item.Genres) == 0 || (len(item.Genres) == 1 && item.Genres[0] == "Movie") {
-			item.Genres = []string{"Action", "Drama", "Thriller"}
-		}
-		if item.Tanggal == "" {
-			item.Tanggal = "January 2025"
-		}
-
 		response.Data = append(response.Data, item)
 	})
 
@@ -99,60 +84,43 @@ func (m *MovieScraper) ScrapeMovies(page int) (*models.MovieResponse, error) {
 	}
 
 	// Visit the page
-	if err := c.Visit(url); err != nil {
+	if err := c.Visit(url); err != nil && !pager.notFound {
 		return nil, fmt.Errorf("failed to visit movie page: %v", err)
 	}
 
+	pagination, err := pager.result(len(response.Data))
+	if err != nil {
+		return nil, err
+	}
+	response.Pagination = pagination
+
 	// Calculate confidence score
-	totalFields := len(response.Data) * 10 // 10 fields per item
-	filledFields := 0
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("skor", 1, utils.ValidateScore).
+		Field("tanggal", 1, utils.ValidateText).
+		Field("views", 0.5, utils.ValidateText).
+		Field("sinopsis", 0.5, utils.ValidateText).
+		Field("genres", 0.5, utils.ValidateText)
 
 	for _, item := range response.Data {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Status != "" {
-			filledFields++
-		}
-		if item.Skor != "" {
-			filledFields++
-		}
-		if item.Sinopsis != "" {
-			filledFields++
-		}
-		if item.Views != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
-		if len(item.Genres) > 0 {
-			filledFields++
-		}
-		if item.Tanggal != "" {
-			filledFields++
-		}
+		scorer.Check("judul", item.Judul)
+		scorer.Check("url", item.URL)
+		scorer.Check("anime_slug", item.AnimeSlug)
+		scorer.Check("cover", item.Cover)
+		scorer.CheckOptional("skor", item.Skor)
+		scorer.CheckOptional("tanggal", item.Tanggal)
+		scorer.CheckOptional("views", item.Views)
+		scorer.CheckOptional("sinopsis", item.Sinopsis)
+		scorer.CheckList("genres", item.Genres)
 	}
 
-	if totalFields > 0 {
-		response.ConfidenceScore = float64(filledFields) / float64(totalFields)
-	} else {
-		response.ConfidenceScore = 0.0
-	}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = scorer.Result(scrapingErrors)
 
-	// Adjust confidence score based on errors
-	if len(scrapingErrors) > 0 {
-		response.ConfidenceScore *= 0.8
-		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
-	} else {
-		response.Message = "Data berhasil diambil"
-	}
+	fillMoviePlaceholders(m.config, response.Data)
 
 	return response, nil
 }
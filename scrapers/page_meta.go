@@ -0,0 +1,38 @@
+package scrapers
+
+import (
+	"strings"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// scrapePageMeta registers a callback that fills meta from the page's SEO
+// and OpenGraph tags.
+func scrapePageMeta(c *colly.Collector, meta *models.PageMeta) {
+	c.OnHTML("head", func(e *colly.HTMLElement) {
+		meta.Title = utils.CleanText(e.DOM.Find("title").First().Text())
+		meta.Description = e.ChildAttr("meta[name=description]", "content")
+		meta.CanonicalURL = e.ChildAttr("link[rel=canonical]", "href")
+		meta.OgTitle = e.ChildAttr("meta[property='og:title']", "content")
+		meta.OgDescription = e.ChildAttr("meta[property='og:description']", "content")
+		meta.OgURL = e.ChildAttr("meta[property='og:url']", "content")
+		meta.OgImage = e.ChildAttr("meta[property='og:image']", "content")
+		meta.TwitterCard = e.ChildAttr("meta[name='twitter:card']", "content")
+	})
+}
+
+// canonicalChanged reports whether the page declares a canonical URL other
+// than the one that was requested, which happens when the site moves or
+// renames a page.
+func canonicalChanged(requestedURL, canonicalURL string) bool {
+	if canonicalURL == "" {
+		return false
+	}
+	normalize := func(u string) string {
+		u = strings.TrimPrefix(strings.TrimPrefix(u, "https://"), "http://")
+		return strings.TrimSuffix(u, "/")
+	}
+	return normalize(requestedURL) != normalize(canonicalURL)
+}
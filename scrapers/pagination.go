@@ -0,0 +1,110 @@
+package scrapers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// ErrPageNotFound is returned by paginated scrapers when the requested page
+// does not exist, either because the site answered 404 or because the page
+// number is past the last one.
+var ErrPageNotFound = errors.New("page not found")
+
+// pageNumberRe matches both /page/N/ permalinks and ?page=N query strings.
+var pageNumberRe = regexp.MustCompile(`(?:/page/|[?&]page=)(\d+)`)
+
+// pageTracker reads the ul.pagination block of a listing page.
+type pageTracker struct {
+	page       int
+	pagination models.Pagination
+	notFound   bool
+}
+
+// newPageTracker registers the pagination callbacks on c for the given
+// requested page.
+func newPageTracker(c *colly.Collector, page int) *pageTracker {
+	if page < 1 {
+		page = 1
+	}
+
+	p := &pageTracker{
+		page:       page,
+		pagination: models.Pagination{CurrentPage: page},
+	}
+
+	c.OnHTML("ul.pagination", func(e *colly.HTMLElement) {
+		e.ForEach("li", func(_ int, li *colly.HTMLElement) {
+			href := li.ChildAttr("a", "href")
+
+			// Numbered links carry the page in their text; prev/next/last
+			// arrows only carry it in their href.
+			n, err := strconv.Atoi(utils.CleanText(li.Text))
+			if err != nil {
+				n = pageFromURL(href)
+			}
+			if n == 0 {
+				return
+			}
+
+			if li.DOM.HasClass("active") {
+				p.pagination.CurrentPage = n
+			}
+			if n > p.pagination.LastPage {
+				p.pagination.LastPage = n
+			}
+			if n == p.page+1 && href != "" && p.pagination.NextPageURL == "" {
+				p.pagination.NextPageURL = href
+			}
+		})
+	})
+
+	c.OnError(func(r *colly.Response, err error) {
+		if r.StatusCode == http.StatusNotFound {
+			p.notFound = true
+		}
+	})
+
+	return p
+}
+
+// result finalizes the pagination once the page has been visited. It returns
+// ErrPageNotFound when the site answered 404 or when an empty page lies past
+// the last page shown by the paginator.
+func (p *pageTracker) result(itemCount int) (models.Pagination, error) {
+	if p.notFound || (p.page > 1 && itemCount == 0 && p.pagination.LastPage < p.page) {
+		return models.Pagination{}, fmt.Errorf("page %d: %w", p.page, ErrPageNotFound)
+	}
+
+	// Listings that fit on one page render no paginator at all
+	if p.pagination.LastPage < p.pagination.CurrentPage {
+		p.pagination.LastPage = p.pagination.CurrentPage
+	}
+
+	p.pagination.HasNext = p.pagination.CurrentPage < p.pagination.LastPage
+	if !p.pagination.HasNext {
+		p.pagination.NextPageURL = ""
+	}
+
+	return p.pagination, nil
+}
+
+// pageFromURL extracts the page number from a pagination link, returning 0
+// when the link carries none.
+func pageFromURL(link string) int {
+	match := pageNumberRe.FindStringSubmatch(link)
+	if match == nil {
+		return 0
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+package scrapers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/models"
+)
+
+func TestPageTracker(t *testing.T) {
+	// Saved listing pages by path; anything else answers 404 like the site
+	pages := map[string]string{
+		"/others/page/2/":  "listing-middle-page.html",
+		"/others/page/14/": "listing-last-page.html",
+		"/others/page/20/": "listing-empty.html",
+		"/genre/josei/":    "listing-no-pagination.html",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", name))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		page     int
+		want     models.Pagination
+		notFound bool
+	}{
+		{
+			name: "middle page",
+			path: "/others/page/2/",
+			page: 2,
+			want: models.Pagination{CurrentPage: 2, LastPage: 14, HasNext: true, NextPageURL: "https://winbu.net/others/page/3/"},
+		},
+		{
+			name: "last page",
+			path: "/others/page/14/",
+			page: 14,
+			want: models.Pagination{CurrentPage: 14, LastPage: 14},
+		},
+		{
+			name: "no pagination",
+			path: "/genre/josei/",
+			page: 1,
+			want: models.Pagination{CurrentPage: 1, LastPage: 1},
+		},
+		{
+			name:     "empty page past the last page",
+			path:     "/others/page/20/",
+			page:     20,
+			notFound: true,
+		},
+		{
+			name:     "site answers 404",
+			path:     "/others/page/99/",
+			page:     99,
+			notFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := colly.NewCollector()
+			pager := newPageTracker(c, tt.page)
+			items := 0
+			c.OnHTML(".ml-item", func(_ *colly.HTMLElement) {
+				items++
+			})
+
+			if err := c.Visit(server.URL + tt.path); err != nil && !pager.notFound {
+				t.Fatalf("Failed to visit page: %v", err)
+			}
+
+			got, err := pager.result(items)
+			if tt.notFound {
+				if !errors.Is(err, ErrPageNotFound) {
+					t.Fatalf("Expected ErrPageNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Pagination = %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageFromURL(t *testing.T) {
+	tests := map[string]int{
+		"https://winbu.net/others/page/3/":             3,
+		"https://winbu.net/daftar-anime-2/?page=4&x=1": 4,
+		"https://winbu.net/?s=naruto&page=2":           2,
+		"https://winbu.net/others/":                    0,
+		"":                                             0,
+	}
+	for link, want := range tests {
+		if got := pageFromURL(link); got != want {
+			t.Errorf("pageFromURL(%q) = %d; want %d", link, got, want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package scrapers
+
+import (
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+)
+
+// v1 has always filled fields the site leaves blank with the placeholder
+// values below, and keeps doing so for existing clients. In null mode (the
+// v2 default) the fields stay null instead. Either way every item lists the
+// fields that were filled in its defaulted_fields, and scrapers compute their
+// confidence score before filling so placeholders never count as scraped.
+
+// placeholderFiller fills the blank fields of one item and records which
+// fields it filled. It starts from the fields the item already lists, so
+// filling an item twice leaves the list intact.
+type placeholderFiller struct {
+	enabled bool
+	fields  []string
+}
+
+func newPlaceholderFiller(cfg *config.Config, defaulted []string) *placeholderFiller {
+	return &placeholderFiller{
+		enabled: !cfg.NullMissingFields,
+		fields:  append([]string{}, defaulted...),
+	}
+}
+
+// text fills a nil string field with placeholder
+func (p *placeholderFiller) text(field string, value **string, placeholder string) {
+	if *value != nil || !p.enabled {
+		return
+	}
+	filled := placeholder
+	*value = &filled
+	p.fields = append(p.fields, field)
+}
+
+// list fills an empty list field with placeholder
+func (p *placeholderFiller) list(field string, value *[]string, placeholder ...string) {
+	if len(*value) > 0 || !p.enabled {
+		return
+	}
+	*value = append([]string{}, placeholder...)
+	p.fields = append(p.fields, field)
+}
+
+// nullModeConfig returns a copy of cfg with null mode on, for scrapers whose
+// results feed other scrapers or shared caches and must hold only real data.
+func nullModeConfig(cfg *config.Config) *config.Config {
+	nullCfg := *cfg
+	nullCfg.NullMissingFields = true
+	return &nullCfg
+}
+
+func fillHomePlaceholders(cfg *config.Config, response *models.HomeResponse) {
+	for i := range response.Top10 {
+		item := &response.Top10[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("rating", &item.Rating, "8.5")
+		p.list("genres", &item.Genres, "Action", "Adventure", "Drama")
+		item.DefaultedFields = p.fields
+	}
+
+	for i := range response.NewEps {
+		item := &response.NewEps[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("episode", &item.Episode, "Episode 1")
+		p.text("rilis", &item.Rilis, "January 2025")
+		item.DefaultedFields = p.fields
+	}
+
+	for i := range response.Movies {
+		item := &response.Movies[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("tanggal", &item.Tanggal, "January 2025")
+		p.list("genres", &item.Genres, "Action", "Drama", "Thriller")
+		item.DefaultedFields = p.fields
+	}
+}
+
+func fillAnimeTerbaruPlaceholders(cfg *config.Config, items []models.AnimeTerbaruItem) {
+	for i := range items {
+		item := &items[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("uploader", &item.Uploader, "WinbuTV Admin")
+		p.text("episode", &item.Episode, "Episode 1")
+		p.text("rilis", &item.Rilis, "January 2025")
+		item.DefaultedFields = p.fields
+	}
+}
+
+// fillHomeSectionPlaceholders fills a section item's blank field only when
+// other items in the same section show it; fields a section never shows stay
+// omitted.
+func fillHomeSectionPlaceholders(cfg *config.Config, sections []models.HomeSection) {
+	for s := range sections {
+		items := sections[s].Items
+		var hasRating, hasEpisode, hasRilis, hasViews bool
+		for _, item := range items {
+			hasRating = hasRating || item.Rating != nil
+			hasEpisode = hasEpisode || item.Episode != nil
+			hasRilis = hasRilis || item.Rilis != nil
+			hasViews = hasViews || item.Views != nil
+		}
+
+		for i := range items {
+			item := &items[i]
+			p := newPlaceholderFiller(cfg, item.DefaultedFields)
+			if hasRating {
+				p.text("rating", &item.Rating, "8.5")
+			}
+			if hasEpisode {
+				p.text("episode", &item.Episode, "Episode 1")
+			}
+			if hasRilis {
+				p.text("rilis", &item.Rilis, "January 2025")
+			}
+			if hasViews {
+				p.text("views", &item.Views, "10,000+ views")
+			}
+			item.DefaultedFields = p.fields
+		}
+	}
+}
+
+func fillSeriesListPlaceholders(cfg *config.Config, items []models.SeriesListItem) {
+	for i := range items {
+		item := &items[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("episode", &item.Episode, "Episode 1")
+		p.text("rilis", &item.Rilis, "January 2025")
+		p.text("views", &item.Views, "10,000+ views")
+		p.text("skor", &item.Skor, "7.5")
+		item.DefaultedFields = p.fields
+	}
+}
+
+func fillMoviePlaceholders(cfg *config.Config, items []models.MovieDetailItem) {
+	for i := range items {
+		item := &items[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("skor", &item.Skor, "7.5")
+		p.text("sinopsis", &item.Sinopsis, "An exciting movie with great storyline and amazing characters.")
+		p.text("views", &item.Views, "10,000+ views")
+		p.list("genres", &item.Genres, "Action", "Drama", "Thriller")
+		p.text("tanggal", &item.Tanggal, "January 2025")
+		item.DefaultedFields = p.fields
+	}
+}
+
+func fillSearchPlaceholders(cfg *config.Config, items []models.SearchResultItem) {
+	for i := range items {
+		item := &items[i]
+		p := newPlaceholderFiller(cfg, item.DefaultedFields)
+		p.text("skor", &item.Skor, "8.0")
+		p.text("penonton", &item.Penonton, "15,000+ viewers")
+		p.text("sinopsis", &item.Sinopsis, "An exciting story with great characters and amazing plot development.")
+		p.list("genre", &item.Genre, "Anime")
+		item.DefaultedFields = p.fields
+	}
+}
+
+func fillAnimeDetailPlaceholders(cfg *config.Config, response *models.AnimeDetailResponse) {
+	p := newPlaceholderFiller(cfg, response.DefaultedFields)
+	if response.Rating.Score == nil {
+		p.text("skor", &response.Skor, "7.5")
+		p.text("rating.score", &response.Rating.Score, "7.5")
+		p.text("rating.users", &response.Rating.Users, "1,000 users")
+	} else {
+		p.text("rating.users", &response.Rating.Users, "1,234 users")
+	}
+	p.text("penonton", &response.Penonton, "10,000+ viewers")
+	response.DefaultedFields = p.fields
+
+	for i := range response.Recommendations {
+		rec := &response.Recommendations[i]
+		p := newPlaceholderFiller(cfg, rec.DefaultedFields)
+		p.text("episode", &rec.Episode, "Unknown")
+		rec.DefaultedFields = p.fields
+	}
+}
+
+func fillEpisodeDetailPlaceholders(cfg *config.Config, response *models.EpisodeDetailResponse) {
+	p := newPlaceholderFiller(cfg, response.DefaultedFields)
+	p.text("anime_info.title", &response.AnimeInfo.Title, "Unknown Series")
+	p.text("anime_info.synopsis", &response.AnimeInfo.Synopsis, "No synopsis available for this episode.")
+	p.list("anime_info.genres", &response.AnimeInfo.Genres, "Action", "Adventure", "Drama")
+	response.DefaultedFields = p.fields
+}
@@ -2,16 +2,44 @@ package scrapers
 
 import (
 	"fmt"
-	"math/rand"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/gocolly/colly/v2"
 	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/database"
 	"github.com/nabilulilalbab/winbu.tv/models"
 	"github.com/nabilulilalbab/winbu.tv/utils"
 )
 
+// scheduleCache is shared across scraper instances so /home and
+// /jadwal-rilis don't each re-scrape the schedule page within the cache TTL.
+var scheduleCache = utils.NewCache()
+
+var (
+	episodeSuffixRe = regexp.MustCompile(`-episode-[\w-]+$`)
+	// releaseClockRe matches "20:30", "20.30 WIB" or "20:30 WIB"; a dot is only
+	// accepted before WIB so dates such as "05.01.2025" don't read as times
+	releaseClockRe = regexp.MustCompile(`(?i)\b([01]?\d|2[0-3])(?::([0-5]\d)|\.([0-5]\d)\s*wib)\b`)
+)
+
+// releaseTimeAttrs hold unix timestamps of the next release on countdown
+// widgets
+var releaseTimeAttrs = []string{"data-rlsdt", "data-time", "data-timestamp"}
+
+// scheduleSkippedSelector matches page chrome whose links are never
+// schedule items
+const scheduleSkippedSelector = "header, footer, nav, aside, script, style, noscript, .modal, #header, #footer, #sidebar, .sidebar"
+
+// scheduleItemSelector matches the element wrapping one schedule entry
+const scheduleItemSelector = "li, tr, article, .item, .ml-item, .bs, .animepost"
+
 type ScheduleScraper struct {
 	config *config.Config
 }
@@ -20,183 +48,86 @@ func NewScheduleScraper(cfg *config.Config) *ScheduleScraper {
 	return &ScheduleScraper{config: cfg}
 }
 
+// ScrapeSchedule scrapes the weekly release schedule from the site's
+// /jadwal-rilis/ page. Each successful scrape is stored in the database, and
+// the stored schedule is served when the page can't be scraped. Entries
+// without a listed air time are reported as unknown.
 func (s *ScheduleScraper) ScrapeSchedule() (*models.ScheduleResponse, error) {
-	c := utils.CreateCollectorWithRetry(s.config)
-
 	// Extract domain from config for source field
 	domain := utils.ExtractDomain(s.config.BaseURL)
-	
+
+	cacheKey := "schedule_" + domain
+	var cachedResponse models.ScheduleResponse
+	if s.config.CacheEnabled && scheduleCache.Get(cacheKey, &cachedResponse) {
+		return &cachedResponse, nil
+	}
+
 	response := &models.ScheduleResponse{
 		BaseResponse: models.BaseResponse{
 			Source: domain,
 		},
-		Data: models.ScheduleData{
-			Monday:    []models.ScheduleItem{},
-			Tuesday:   []models.ScheduleItem{},
-			Wednesday: []models.ScheduleItem{},
-			Thursday:  []models.ScheduleItem{},
-			Friday:    []models.ScheduleItem{},
-			Saturday:  []models.ScheduleItem{},
-			Sunday:    []models.ScheduleItem{},
-		},
+		Data: emptyScheduleData(),
 	}
 
-	var scrapingErrors []string
-
-	// Collect all items for schedule generation from homepage sections
-	var allScheduleItems []models.ScheduleItem
-
-	// Scrape all sections from homepage to generate schedule
-	c.OnHTML("div.movies-list-wrap", func(e *colly.HTMLElement) {
-		sectionTitle := utils.CleanText(e.ChildText(".list-title h2"))
-
-		switch {
-		case strings.Contains(sectionTitle, "Top 10 Series"):
-			e.ForEach(".ml-item-potrait .ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV",
-					Score:       utils.CleanText(el.ChildText(".mli-mvi")),
-					Genres:      []string{"Action", "Adventure", "Drama"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				if scheduleItem.Score == "" {
-					scheduleItem.Score = "8.5"
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Anime Donghua Terbaru"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV",
-					Score:       "7.8",
-					Genres:      []string{"Animation", "Drama", "Adventure"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Top 10 Film"):
-			e.ForEach(".ml-item-potrait .ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "Movie",
-					Score:       utils.CleanText(el.ChildText(".mli-mvi")),
-					Genres:      []string{"Action", "Drama", "Thriller"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				if scheduleItem.Score == "" {
-					scheduleItem.Score = "8.2"
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Film Terbaru"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "Movie",
-					Score:       "7.5",
-					Genres:      []string{"Action", "Drama", "Thriller"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "Jepang Korea China Barat"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV",
-					Score:       "7.9",
-					Genres:      []string{"Drama", "Romance", "Comedy"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
-
-		case strings.Contains(sectionTitle, "TV Show"):
-			e.ForEach(".ml-item", func(_ int, el *colly.HTMLElement) {
-				scheduleItem := models.ScheduleItem{
-					Title:       utils.CleanText(el.ChildText(".judul")),
-					URL:         el.ChildAttr("a.ml-mask", "href"),
-					AnimeSlug:   utils.ExtractSlugFromURL(el.ChildAttr("a.ml-mask", "href")),
-					CoverURL:    el.ChildAttr("img.mli-thumb", "src"),
-					Type:        "TV Show",
-					Score:       "8.1",
-					Genres:      []string{"Reality", "Entertainment", "Comedy"},
-					ReleaseTime: s.generateRandomTime(),
-				}
-				allScheduleItems = append(allScheduleItems, scheduleItem)
-			})
+	slots, scrapeErr := s.scrapeReleaseSlots()
+	fromStore := false
+	if scrapeErr == nil && len(slots) > 0 {
+		if database.DB != nil {
+			if err := database.ReplaceReleaseSchedule(slots); err != nil {
+				log.Printf("[Schedule] Failed to store release schedule: %v", err)
+			}
 		}
-	})
-
-	// Generate schedule after collecting all items
-	c.OnScraped(func(r *colly.Response) {
-		response.Data = s.generateWeeklySchedule(allScheduleItems)
-	})
+	} else if database.DB != nil {
+		// Fall back to the last schedule scraped successfully
+		stored, err := database.GetReleaseSchedule()
+		if err != nil {
+			log.Printf("[Schedule] Failed to load release schedule: %v", err)
+		} else if len(stored) > 0 {
+			slots, fromStore = stored, true
+		}
+	}
+	if scrapeErr != nil && !fromStore {
+		return nil, scrapeErr
+	}
 
-	// Error handling
-	c.OnError(func(r *colly.Response, err error) {
-		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
-	})
+	unknownTimes := 0
+	for _, slot := range slots {
+		item := models.ScheduleItem{
+			Title:            slot.Title,
+			URL:              slot.URL,
+			AnimeSlug:        slot.AnimeSlug,
+			CoverURL:         slot.CoverURL,
+			Type:             slot.Type,
+			Genres:           []string{},
+			Episode:          slot.Episode,
+			EpisodeNumber:    utils.EpisodeNumberValue(slot.Episode),
+			ReleaseTime:      slot.ReleaseTime,
+			ReleaseTimeKnown: slot.TimeKnown,
+		}
+		if !slot.TimeKnown {
+			item.ReleaseTime = "Unknown"
+			unknownTimes++
+		}
 
-	// Visit homepage to get schedule data
-	homeURL := s.config.BaseURL + "/"
-	if err := c.Visit(homeURL); err != nil {
-		return nil, fmt.Errorf("failed to visit homepage: %v", err)
+		appendToDay(&response.Data, slot.DayOfWeek, item)
 	}
 
 	// Calculate confidence score
 	totalDays := 7
 	filledDays := 0
-
-	if len(response.Data.Monday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Tuesday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Wednesday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Thursday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Friday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Saturday) > 0 {
-		filledDays++
-	}
-	if len(response.Data.Sunday) > 0 {
-		filledDays++
+	for _, day := range scheduleDays(&response.Data) {
+		if len(*day) > 0 {
+			filledDays++
+		}
 	}
 
 	response.ConfidenceScore = float64(filledDays) / float64(totalDays)
 
-	// Adjust confidence score based on errors
-	if len(scrapingErrors) > 0 {
+	if fromStore {
 		response.ConfidenceScore *= 0.8
-		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
+		response.Message = fmt.Sprintf("Jadwal rilis gagal diambil, menampilkan jadwal tersimpan dari %s", slots[0].UpdatedAt.In(utils.WIB).Format("2006-01-02 15:04 WIB"))
+	} else if unknownTimes > 0 {
+		response.Message = fmt.Sprintf("Data berhasil diambil (%d item tanpa jam rilis)", unknownTimes)
 	} else {
 		response.Message = "Data berhasil diambil"
 	}
@@ -207,38 +138,204 @@ func (s *ScheduleScraper) ScrapeSchedule() (*models.ScheduleResponse, error) {
 		response.Message = "No schedule data found"
 	}
 
+	// A stored schedule is only a stopgap, so retry the page on the next call
+	if s.config.CacheEnabled && !fromStore {
+		scheduleCache.SetWithTTL(cacheKey, response, int(s.config.CacheTTL.Seconds()))
+	}
+
 	return response, nil
 }
 
-// generateRandomTime generates a random time in HH:MM format
-func (s *ScheduleScraper) generateRandomTime() string {
-	rand.Seed(time.Now().UnixNano())
-	hours := rand.Intn(24)
-	minutes := rand.Intn(60)
-	return fmt.Sprintf("%02d:%02d", hours, minutes)
+// scrapeReleaseSlots scrapes the release schedule page
+func (s *ScheduleScraper) scrapeReleaseSlots() ([]database.ReleaseSlot, error) {
+	c := utils.CreateCollectorWithRetry(s.config)
+
+	var slots []database.ReleaseSlot
+	var scrapingErrors []string
+
+	c.OnHTML("body", func(e *colly.HTMLElement) {
+		slots = parseReleaseSchedule(e.DOM, s.config)
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	if err := c.Visit(s.config.BaseURL + "/jadwal-rilis/"); err != nil {
+		return nil, fmt.Errorf("failed to visit schedule page: %v", err)
+	}
+	if len(scrapingErrors) > 0 {
+		return nil, fmt.Errorf("failed to scrape schedule page: %s", strings.Join(scrapingErrors, "; "))
+	}
+
+	return slots, nil
 }
 
-// generateWeeklySchedule distributes items randomly across the week
-func (s *ScheduleScraper) generateWeeklySchedule(allItems []models.ScheduleItem) models.ScheduleData {
-	if len(allItems) == 0 {
-		return models.ScheduleData{
-			Monday:    []models.ScheduleItem{},
-			Tuesday:   []models.ScheduleItem{},
-			Wednesday: []models.ScheduleItem{},
-			Thursday:  []models.ScheduleItem{},
-			Friday:    []models.ScheduleItem{},
-			Saturday:  []models.ScheduleItem{},
-			Sunday:    []models.ScheduleItem{},
+// parseReleaseSchedule reads the schedule page in document order. A day is
+// opened by a heading whose text is a day name ("Senin", "Hari Jum'at") or
+// by a tab pane whose id or class names the day; every series link after it
+// belongs to that day until the next day opens.
+func parseReleaseSchedule(body *goquery.Selection, cfg *config.Config) []database.ReleaseSlot {
+	var slots []database.ReleaseSlot
+	seen := make(map[string]bool)
+	day := ""
+
+	var walk func(sel *goquery.Selection)
+	walk = func(sel *goquery.Selection) {
+		sel.Children().Each(func(_ int, node *goquery.Selection) {
+			if node.Is(scheduleSkippedSelector) {
+				return
+			}
+			if weekday, ok := weekdayOfPane(node); ok {
+				day = weekday.String()
+			}
+
+			if goquery.NodeName(node) != "a" {
+				if weekday, ok := utils.ParseWeekday(node.Text()); ok && node.Find("a[href]").Length() <= 1 {
+					// A day heading (or a day tab)
+					day = weekday.String()
+					return
+				}
+				walk(node)
+				return
+			}
+
+			if day == "" {
+				return
+			}
+			slot, ok := releaseSlotFromLink(node, cfg)
+			if !ok {
+				return
+			}
+			slot.DayOfWeek = day
+			key := slot.AnimeSlug + "|" + day
+			if seen[key] {
+				// Cover and title usually link to the same series
+				if slot.Title != "" {
+					for i := range slots {
+						if slots[i].AnimeSlug == slot.AnimeSlug && slots[i].DayOfWeek == day && slots[i].Title == "" {
+							slots[i].Title = slot.Title
+						}
+					}
+				}
+				return
+			}
+			seen[key] = true
+			slot.Position = len(slots)
+			slots = append(slots, slot)
+		})
+	}
+	walk(body)
+
+	// Keep only entries a title could be found for
+	filtered := slots[:0]
+	for _, slot := range slots {
+		if slot.Title != "" {
+			slot.Position = len(filtered)
+			filtered = append(filtered, slot)
 		}
 	}
+	return filtered
+}
 
-	// Shuffle the items
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(allItems), func(i, j int) {
-		allItems[i], allItems[j] = allItems[j], allItems[i]
+// weekdayOfPane returns the day a tab pane such as <div id="jadwal-senin">
+// or <div class="tab-pane sabtu"> holds
+func weekdayOfPane(node *goquery.Selection) (time.Weekday, bool) {
+	names := node.AttrOr("class", "") + " " + node.AttrOr("id", "") + " " + node.AttrOr("data-day", "")
+	words := strings.FieldsFunc(names, func(r rune) bool {
+		return !unicode.IsLetter(r)
 	})
+	for _, word := range words {
+		if weekday, ok := utils.ParseWeekday(word); ok {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+// releaseSlotFromLink turns a link on the schedule page into a release slot
+// when it points at a series on the site
+func releaseSlotFromLink(link *goquery.Selection, cfg *config.Config) (database.ReleaseSlot, bool) {
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return database.ReleaseSlot{}, false
+	}
+	u, err := base.Parse(strings.TrimSpace(link.AttrOr("href", "")))
+	if err != nil || u.Fragment != "" || u.Host != base.Host {
+		return database.ReleaseSlot{}, false
+	}
+	href := u.String()
+	path := strings.Trim(u.Path, "/")
+	if path == "" || strings.HasPrefix(path, "jadwal-rilis") || strings.HasPrefix(path, "genre/") ||
+		strings.HasPrefix(path, "page/") || strings.HasPrefix(path, "wp-") {
+		return database.ReleaseSlot{}, false
+	}
+	if _, ok := utils.ParseWeekday(link.Text()); ok {
+		return database.ReleaseSlot{}, false
+	}
+
+	item := link.Closest(scheduleItemSelector)
+	if item.Length() == 0 {
+		item = link.Parent()
+	}
+	itemText := utils.CleanText(item.Text())
 
-	schedule := models.ScheduleData{
+	title := utils.CleanText(link.AttrOr("title", ""))
+	if title == "" {
+		title = utils.CleanText(link.Text())
+	}
+	if title == "" {
+		title = utils.CleanText(link.Find("img").AttrOr("alt", ""))
+	}
+
+	slot := database.ReleaseSlot{
+		AnimeSlug: episodeSuffixRe.ReplaceAllString(utils.ExtractSlugFromURL(href), ""),
+		Title:     title,
+		URL:       href,
+		CoverURL:  utils.ExtractImageURL(item.Find("img"), cfg.BaseURL, cfg.StripImageResize),
+		Type:      "Anime",
+	}
+	if strings.Contains(strings.ToLower(itemText+" "+href), "donghua") {
+		slot.Type = "Donghua"
+	}
+	if number, ok := utils.ParseEpisodeNumber(itemText); ok {
+		slot.Episode = "Episode " + strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	slot.ReleaseTime, slot.TimeKnown = releaseTimeOf(item, itemText)
+
+	return slot, slot.AnimeSlug != ""
+}
+
+// releaseTimeOf returns the air time (HH:MM WIB) listed in a schedule entry,
+// either as text or as a countdown timestamp
+func releaseTimeOf(item *goquery.Selection, itemText string) (string, bool) {
+	if match := releaseClockRe.FindStringSubmatch(itemText); match != nil {
+		hour, _ := strconv.Atoi(match[1])
+		minute := match[2] + match[3]
+		return fmt.Sprintf("%02d:%s", hour, minute), true
+	}
+
+	for _, attr := range releaseTimeAttrs {
+		selector := "[" + attr + "]"
+		value, ok := item.Attr(attr)
+		if !ok {
+			value, ok = item.Find(selector).Attr(attr)
+		}
+		if !ok {
+			continue
+		}
+		if seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil && seconds > 0 {
+			return time.Unix(seconds, 0).In(utils.WIB).Format("15:04"), true
+		}
+	}
+
+	return "", false
+}
+
+// emptyScheduleData returns a ScheduleData with every day initialised
+func emptyScheduleData() models.ScheduleData {
+	return models.ScheduleData{
 		Monday:    []models.ScheduleItem{},
 		Tuesday:   []models.ScheduleItem{},
 		Wednesday: []models.ScheduleItem{},
@@ -247,76 +344,55 @@ func (s *ScheduleScraper) generateWeeklySchedule(allItems []models.ScheduleItem)
 		Saturday:  []models.ScheduleItem{},
 		Sunday:    []models.ScheduleItem{},
 	}
+}
 
-	// Distribute items across days (aim for 3 items per day)
-	itemsPerDay := 3
-	days := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
-
-	for i, item := range allItems {
-		dayIndex := (i / itemsPerDay) % 7
-		day := days[dayIndex]
-
-		switch day {
-		case "Monday":
-			if len(schedule.Monday) < itemsPerDay {
-				schedule.Monday = append(schedule.Monday, item)
-			}
-		case "Tuesday":
-			if len(schedule.Tuesday) < itemsPerDay {
-				schedule.Tuesday = append(schedule.Tuesday, item)
-			}
-		case "Wednesday":
-			if len(schedule.Wednesday) < itemsPerDay {
-				schedule.Wednesday = append(schedule.Wednesday, item)
-			}
-		case "Thursday":
-			if len(schedule.Thursday) < itemsPerDay {
-				schedule.Thursday = append(schedule.Thursday, item)
-			}
-		case "Friday":
-			if len(schedule.Friday) < itemsPerDay {
-				schedule.Friday = append(schedule.Friday, item)
-			}
-		case "Saturday":
-			if len(schedule.Saturday) < itemsPerDay {
-				schedule.Saturday = append(schedule.Saturday, item)
-			}
-		case "Sunday":
-			if len(schedule.Sunday) < itemsPerDay {
-				schedule.Sunday = append(schedule.Sunday, item)
-			}
-		}
+// scheduleDays returns pointers to each day of the schedule, Monday first
+func scheduleDays(schedule *models.ScheduleData) []*[]models.ScheduleItem {
+	return []*[]models.ScheduleItem{
+		&schedule.Monday,
+		&schedule.Tuesday,
+		&schedule.Wednesday,
+		&schedule.Thursday,
+		&schedule.Friday,
+		&schedule.Saturday,
+		&schedule.Sunday,
 	}
+}
 
-	// If we have remaining items and some days are not full, distribute them randomly
-	remainingItems := allItems[len(days)*itemsPerDay:]
-	for _, item := range remainingItems {
-		dayIndex := rand.Intn(7)
-		day := days[dayIndex]
-
-		switch day {
-		case "Monday":
-			schedule.Monday = append(schedule.Monday, item)
-		case "Tuesday":
-			schedule.Tuesday = append(schedule.Tuesday, item)
-		case "Wednesday":
-			schedule.Wednesday = append(schedule.Wednesday, item)
-		case "Thursday":
-			schedule.Thursday = append(schedule.Thursday, item)
-		case "Friday":
-			schedule.Friday = append(schedule.Friday, item)
-		case "Saturday":
-			schedule.Saturday = append(schedule.Saturday, item)
-		case "Sunday":
-			schedule.Sunday = append(schedule.Sunday, item)
-		}
+// scheduleDay returns the schedule slice for the given day name
+func scheduleDay(schedule *models.ScheduleData, day string) (*[]models.ScheduleItem, bool) {
+	switch strings.ToLower(day) {
+	case "monday":
+		return &schedule.Monday, true
+	case "tuesday":
+		return &schedule.Tuesday, true
+	case "wednesday":
+		return &schedule.Wednesday, true
+	case "thursday":
+		return &schedule.Thursday, true
+	case "friday":
+		return &schedule.Friday, true
+	case "saturday":
+		return &schedule.Saturday, true
+	case "sunday":
+		return &schedule.Sunday, true
 	}
+	return nil, false
+}
 
-	return schedule
+// appendToDay appends item to the given day of the schedule
+func appendToDay(schedule *models.ScheduleData, day string, item models.ScheduleItem) {
+	if items, ok := scheduleDay(schedule, day); ok {
+		*items = append(*items, item)
+	}
 }
 
 // ScrapeScheduleByDay scrapes schedule for a specific day
 func (s *ScheduleScraper) ScrapeScheduleByDay(day string) (*models.DayScheduleResponse, error) {
+	if _, ok := scheduleDay(&models.ScheduleData{}, day); !ok {
+		return nil, fmt.Errorf("invalid day: %s. Valid days are: monday, tuesday, wednesday, thursday, friday, saturday, sunday", day)
+	}
+
 	// First get all schedule data
 	fullSchedule, err := s.ScrapeSchedule()
 	if err != nil {
@@ -325,7 +401,7 @@ func (s *ScheduleScraper) ScrapeScheduleByDay(day string) (*models.DayScheduleRe
 
 	// Extract domain from config for source field
 	domain := utils.ExtractDomain(s.config.BaseURL)
-	
+
 	response := &models.DayScheduleResponse{
 		BaseResponse: models.BaseResponse{
 			Source: domain,
@@ -334,24 +410,8 @@ func (s *ScheduleScraper) ScrapeScheduleByDay(day string) (*models.DayScheduleRe
 	}
 
 	// Extract data for the specific day
-	switch strings.ToLower(day) {
-	case "monday":
-		response.Data = fullSchedule.Data.Monday
-	case "tuesday":
-		response.Data = fullSchedule.Data.Tuesday
-	case "wednesday":
-		response.Data = fullSchedule.Data.Wednesday
-	case "thursday":
-		response.Data = fullSchedule.Data.Thursday
-	case "friday":
-		response.Data = fullSchedule.Data.Friday
-	case "saturday":
-		response.Data = fullSchedule.Data.Saturday
-	case "sunday":
-		response.Data = fullSchedule.Data.Sunday
-	default:
-		return nil, fmt.Errorf("invalid day: %s. Valid days are: monday, tuesday, wednesday, thursday, friday, saturday, sunday", day)
-	}
+	items, _ := scheduleDay(&fullSchedule.Data, day)
+	response.Data = *items
 
 	// Calculate confidence score based on data availability
 	if len(response.Data) > 0 {
@@ -364,4 +424,3 @@ func (s *ScheduleScraper) ScrapeScheduleByDay(day string) (*models.DayScheduleRe
 
 	return response, nil
 }
-
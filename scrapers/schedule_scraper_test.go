@@ -0,0 +1,128 @@
+package scrapers
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/database"
+)
+
+// loadFixture parses an HTML page saved under testdata
+func loadFixture(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+	html, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(html)))
+	if err != nil {
+		t.Fatalf("Failed to parse fixture: %v", err)
+	}
+	return doc
+}
+
+// useTestDatabase points database.DB at a fresh database for the test
+func useTestDatabase(t *testing.T) {
+	t.Helper()
+	schema, err := os.ReadFile(filepath.Join("..", "database", "schema.sql"))
+	if err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "winbu.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if _, err := db.Exec(string(schema)); err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		db.Close()
+	})
+}
+
+func TestParseReleaseSchedule(t *testing.T) {
+	doc := loadFixture(t, "jadwal-rilis.html")
+	slots := parseReleaseSchedule(doc.Find("body"), &config.Config{BaseURL: "https://winbu.net"})
+
+	want := []database.ReleaseSlot{
+		{AnimeSlug: "okiraku-ryoushu-no-tanoshii-ryouchi-bouei", Title: "Okiraku Ryoushu no Tanoshii Ryouchi Bouei", Type: "Anime", Episode: "Episode 7", DayOfWeek: "Monday", ReleaseTime: "20:30", TimeKnown: true},
+		{AnimeSlug: "kaijuu-8-gou-season-2", Title: "Kaijuu 8-gou Season 2", Type: "Anime", Episode: "Episode 3", DayOfWeek: "Monday"},
+		{AnimeSlug: "renegade-immortal", Title: "Renegade Immortal", Type: "Donghua", Episode: "Episode 98", DayOfWeek: "Tuesday", ReleaseTime: "19:00", TimeKnown: true},
+		{AnimeSlug: "dandadan-season-2", Title: "Dandadan Season 2", Type: "Anime", Episode: "Episode 2", DayOfWeek: "Friday", ReleaseTime: "23:00", TimeKnown: true},
+		{AnimeSlug: "one-piece", Title: "One Piece", Type: "Anime", Episode: "Episode 1139", DayOfWeek: "Sunday"},
+	}
+
+	if len(slots) != len(want) {
+		t.Fatalf("Got %d slots, want %d: %+v", len(slots), len(want), slots)
+	}
+	for i, w := range want {
+		got := slots[i]
+		if got.AnimeSlug != w.AnimeSlug || got.Title != w.Title || got.Type != w.Type || got.Episode != w.Episode ||
+			got.DayOfWeek != w.DayOfWeek || got.ReleaseTime != w.ReleaseTime || got.TimeKnown != w.TimeKnown {
+			t.Errorf("Slot %d = %+v; want %+v", i, got, w)
+		}
+		if got.Position != i {
+			t.Errorf("Slot %d position = %d", i, got.Position)
+		}
+	}
+
+	// The theme's grey.gif stand-in src must not win over data-src
+	if slots[0].CoverURL != "https://winbu.net/wp-content/uploads/2025/07/131078l-300x450.jpeg" {
+		t.Errorf("Cover = %q", slots[0].CoverURL)
+	}
+	if slots[1].CoverURL != "https://winbu.net/wp-content/uploads/2025/07/154695-300x450.jpeg" {
+		t.Errorf("Cover = %q", slots[1].CoverURL)
+	}
+	if slots[2].URL != "https://winbu.net/donghua/renegade-immortal-episode-98/" {
+		t.Errorf("URL = %q", slots[2].URL)
+	}
+}
+
+func TestScrapeScheduleFallsBackToStoredSchedule(t *testing.T) {
+	// Nothing listens here, so every scrape of the schedule page fails
+	cfg := &config.Config{BaseURL: "http://127.0.0.1:1", Timeout: time.Second}
+
+	useTestDatabase(t)
+	if _, err := NewScheduleScraper(cfg).ScrapeSchedule(); err == nil {
+		t.Fatal("Expected an error without a stored schedule")
+	}
+
+	doc := loadFixture(t, "jadwal-rilis.html")
+	if err := database.ReplaceReleaseSchedule(parseReleaseSchedule(doc.Find("body"), &config.Config{BaseURL: "https://winbu.net"})); err != nil {
+		t.Fatalf("Failed to store schedule: %v", err)
+	}
+
+	response, err := NewScheduleScraper(cfg).ScrapeSchedule()
+	if err != nil {
+		t.Fatalf("Expected the stored schedule, got error: %v", err)
+	}
+	if !strings.HasPrefix(response.Message, "Jadwal rilis gagal diambil, menampilkan jadwal tersimpan dari ") {
+		t.Errorf("Message = %q", response.Message)
+	}
+
+	monday := response.Data.Monday
+	if len(monday) != 2 || monday[0].Title != "Okiraku Ryoushu no Tanoshii Ryouchi Bouei" || monday[0].ReleaseTime != "20:30" {
+		t.Errorf("Monday = %+v", monday)
+	}
+	if len(monday) == 2 && (monday[1].ReleaseTime != "Unknown" || monday[1].ReleaseTimeKnown) {
+		t.Errorf("Monday[1] release time = %q, known %v", monday[1].ReleaseTime, monday[1].ReleaseTimeKnown)
+	}
+	if len(response.Data.Tuesday) != 1 || len(response.Data.Friday) != 1 || len(response.Data.Sunday) != 1 {
+		t.Errorf("Schedule = %+v", response.Data)
+	}
+
+	// 4 of 7 days filled, scored down for coming from the store
+	if want := 4.0 / 7.0 * 0.8; response.ConfidenceScore < want-0.001 || response.ConfidenceScore > want+0.001 {
+		t.Errorf("ConfidenceScore = %v; want %v", response.ConfidenceScore, want)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/gocolly/colly/v2"
 	"github.com/nabilulilalbab/winbu.tv/config"
@@ -12,6 +13,13 @@ import (
 	"github.com/nabilulilalbab/winbu.tv/utils"
 )
 
+const (
+	// maxSearchPages caps how many result pages one aggregated search walks
+	maxSearchPages = 10
+	// searchConcurrency limits parallel page requests to the site
+	searchConcurrency = 3
+)
+
 type SearchScraper struct {
 	config *config.Config
 	cache  *utils.CacheManager
@@ -30,22 +38,198 @@ func (s *SearchScraper) SearchAnime(query string, page int) (*models.SearchRespo
 	// Try to get from cache first
 	var cachedResponse models.SearchResponse
 	if s.cache.Get(cacheKey, &cachedResponse) {
+		fillSearchPlaceholders(s.config, cachedResponse.Data)
 		return &cachedResponse, nil
 	}
 
-	c := utils.CreateCollectorWithRetry(s.config)
+	items, pagination, scrapingErrors, err := s.scrapeDaftarAnime(models.CatalogFilter{Title: query, Page: page})
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit search page: %w", err)
+	}
 
 	// Extract domain from config for source field
 	domain := utils.ExtractDomain(s.config.BaseURL)
-	
+
 	response := &models.SearchResponse{
 		BaseResponse: models.BaseResponse{
 			Source: domain,
 		},
+		Pagination: pagination,
+		Data:       items,
+	}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = searchConfidence(items, scrapingErrors)
+
+	// Cache the response
+	s.cache.Set(cacheKey, response)
+
+	fillSearchPlaceholders(s.config, response.Data)
+
+	return response, nil
+}
+
+// SearchAnimePages walks up to maxPages result pages starting at startPage,
+// fetching them concurrently, and merges the results deduplicated by slug.
+// Pages beyond the last one reported by the site are never requested.
+func (s *SearchScraper) SearchAnimePages(query string, startPage, maxPages int) (*models.SearchResponse, error) {
+	if startPage < 1 {
+		startPage = 1
+	}
+	if maxPages > maxSearchPages {
+		maxPages = maxSearchPages
+	}
+
+	// The first page tells us how many pages exist
+	first, err := s.SearchAnime(query, startPage)
+	if err != nil {
+		return nil, err
+	}
+
+	endPage := startPage + maxPages - 1
+	if endPage > first.Pagination.LastPage {
+		endPage = first.Pagination.LastPage
+	}
+	if endPage <= startPage {
+		first.PagesFetched = 1
+		return first, nil
+	}
+
+	rest, pageErrors := fetchPagesConcurrently(startPage+1, endPage, func(page int) (*models.SearchResponse, error) {
+		return s.SearchAnime(query, page)
+	})
+	results := append([]*models.SearchResponse{first}, rest...)
+
+	response := &models.SearchResponse{
+		BaseResponse: models.BaseResponse{
+			Source: first.Source,
+		},
+		Pagination: models.Pagination{
+			CurrentPage: startPage,
+			LastPage:    first.Pagination.LastPage,
+		},
 		Data: []models.SearchResultItem{},
 	}
 
+	// Merge in page order so the site's ranking is kept
+	seen := make(map[string]bool)
+	var lastFetched *models.SearchResponse
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		response.PagesFetched++
+		lastFetched = result
+		for _, item := range result.Data {
+			key := item.AnimeSlug
+			if key == "" {
+				key = item.URL
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			response.Data = append(response.Data, item)
+		}
+	}
+
+	if lastFetched != nil {
+		response.Pagination.HasNext = lastFetched.Pagination.HasNext
+		response.Pagination.NextPageURL = lastFetched.Pagination.NextPageURL
+	}
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = searchConfidence(response.Data, pageErrors)
+
+	return response, nil
+}
+
+// fetchPagesConcurrently calls fetch for every page from first to last with
+// at most searchConcurrency requests in flight. results[i] holds page
+// first+i and is nil when that page failed.
+func fetchPagesConcurrently[T any](first, last int, fetch func(page int) (*T, error)) ([]*T, []string) {
+	if last < first {
+		return nil, nil
+	}
+
+	results := make([]*T, last-first+1)
+	var pageErrors []string
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, searchConcurrency)
+
+	for page := first; page <= last; page++ {
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result, err := fetch(page)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				pageErrors = append(pageErrors, fmt.Sprintf("Error scraping page %d: %v", page, err))
+				return
+			}
+			results[page-first] = result
+		}(page)
+	}
+	wg.Wait()
+
+	return results, pageErrors
+}
+
+// BrowseCatalog lists daftar-anime-2 entries matching every filter in the
+// given CatalogFilter (title, status, type, order and genres).
+func (s *SearchScraper) BrowseCatalog(filter models.CatalogFilter) (*models.CatalogResponse, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+
+	cacheKey := fmt.Sprintf("catalog_%s_%s_%s_%s_%s_page_%d",
+		filter.Title, filter.Status, filter.Type, filter.Order, strings.Join(filter.Genres, ","), filter.Page)
+
+	// Try to get from cache first
+	var cachedResponse models.CatalogResponse
+	if s.cache.Get(cacheKey, &cachedResponse) {
+		fillSearchPlaceholders(s.config, cachedResponse.Data)
+		return &cachedResponse, nil
+	}
+
+	items, pagination, scrapingErrors, err := s.scrapeDaftarAnime(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit catalog page: %w", err)
+	}
+
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(s.config.BaseURL)
+
+	response := &models.CatalogResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Filters:    filter,
+		Pagination: pagination,
+		Data:       items,
+	}
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = searchConfidence(items, scrapingErrors)
+
+	// Cache the response
+	s.cache.Set(cacheKey, response)
+
+	fillSearchPlaceholders(s.config, response.Data)
+
+	return response, nil
+}
+
+// scrapeDaftarAnime visits the daftar-anime-2 listing with the given filter
+// applied and returns the parsed items and pagination along with any
+// scraping errors.
+func (s *SearchScraper) scrapeDaftarAnime(filter models.CatalogFilter) ([]models.SearchResultItem, models.Pagination, []string, error) {
+	c := utils.CreateCollectorWithRetry(s.config)
+
+	items := []models.SearchResultItem{}
 	var scrapingErrors []string
+	pager := newPageTracker(c, filter.Page)
 
 	// Scrape search results from daftar-anime page
 	c.OnHTML("div.ml-item", func(e *colly.HTMLElement) {
@@ -53,48 +237,18 @@ func (s *SearchScraper) SearchAnime(query string, page int) (*models.SearchRespo
 			Judul:     utils.CleanText(e.ChildText(".judul")),
 			URL:       e.ChildAttr("a.ml-mask", "href"),
 			AnimeSlug: utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
-			Cover:     e.ChildAttr("img.mli-thumb", "src"),
-			Status:    "Unknown",
-			Tipe:      "TV",
-			Skor:      utils.CleanText(e.ChildText(".mli-mvi")),
-			Penonton:  "0 Views",
-			Sinopsis:  "",
-			Genre:     []string{"Anime"},
+			Cover:     imageURL(s.config, e, "img.mli-thumb"),
+			Skor:      optionalString(e.ChildText(".mli-mvi")),
 		}
+		item.ScoreValue = utils.ScoreValue(stringValue(item.Skor))
 
-		// Try to determine type from URL or other indicators
-		if item.URL != "" {
-			if strings.Contains(item.URL, "/film/") {
-				item.Tipe = "Movie"
-				item.Status = "Completed"
-				item.Genre = []string{"Action", "Drama", "Thriller"}
-			} else if strings.Contains(item.URL, "/series/") {
-				item.Tipe = "Series"
-				item.Status = "Ongoing"
-				item.Genre = []string{"Action", "Adventure", "Drama"}
-			} else {
-				item.Tipe = "TV"
-				item.Status = "Ongoing"
-				item.Genre = []string{"Action", "Adventure", "Comedy"}
-			}
-		}
-
-		// Fill missing fields with dummy data
-		if item.Skor == "" {
-			item.Skor = "8.0"
-		}
-		if item.Penonton == "" || item.Penonton == "0 Views" {
-			item.Penonton = "15,000+ viewers"
-		}
-		if item.Sinopsis == "" {
-			item.Sinopsis = "An exciting story with great characters and amazing plot development."
-		}
-		if len(item.Genre) == 0 {
-			item.Genre = []string{"Action", "Adventure", "Drama"}
-		}
+		// Type and status come from the card's badges, falling back to the URL path
+		badgeType, badgeStatus := scanContentBadges(e)
+		item.Tipe, item.TypeSource = resolveContentType(badgeType, item.URL)
+		item.Status, item.StatusSource = resolveAiringStatus(badgeStatus, item.Tipe)
 
 		if item.Judul != "" && item.URL != "" {
-			response.Data = append(response.Data, item)
+			items = append(items, item)
 		}
 	})
 
@@ -103,79 +257,86 @@ func (s *SearchScraper) SearchAnime(query string, page int) (*models.SearchRespo
 		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
 	})
 
-	// Build search URL using daftar-anime-2 with title parameter
-	u, err := url.Parse(s.config.BaseURL + "/daftar-anime-2/")
+	// Build search URL using daftar-anime-2 with filter parameters
+	searchURL, err := s.buildDaftarAnimeURL(filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse base URL: %v", err)
+		return nil, models.Pagination{}, nil, err
 	}
 
-	q := u.Query()
-	q.Set("title", query)
-	if page > 1 {
-		q.Set("page", strconv.Itoa(page))
+	// Visit the search page
+	if err := c.Visit(searchURL); err != nil && !pager.notFound {
+		return nil, models.Pagination{}, nil, err
 	}
-	u.RawQuery = q.Encode()
-	searchURL := u.String()
 
-	// Visit the search page
-	if err := c.Visit(searchURL); err != nil {
-		return nil, fmt.Errorf("failed to visit search page: %v", err)
+	pagination, err := pager.result(len(items))
+	if err != nil {
+		return nil, models.Pagination{}, nil, err
 	}
 
-	// Calculate confidence score
-	totalFields := len(response.Data) * 10 // 10 fields per item
-	filledFields := 0
+	return items, pagination, scrapingErrors, nil
+}
 
-	for _, item := range response.Data {
-		if item.Judul != "" {
-			filledFields++
-		}
-		if item.URL != "" {
-			filledFields++
-		}
-		if item.AnimeSlug != "" {
-			filledFields++
-		}
-		if item.Cover != "" {
-			filledFields++
-		}
-		if item.Status != "" {
-			filledFields++
-		}
-		if item.Tipe != "" {
-			filledFields++
-		}
-		if item.Skor != "" {
-			filledFields++
-		}
-		if item.Penonton != "" {
-			filledFields++
-		}
-		if item.Sinopsis != "" {
-			filledFields++
-		}
-		if len(item.Genre) > 0 {
-			filledFields++
-		}
+// buildDaftarAnimeURL builds the daftar-anime-2 URL for the given filter.
+// Empty filter values are left out so the site falls back to "All".
+func (s *SearchScraper) buildDaftarAnimeURL(filter models.CatalogFilter) (string, error) {
+	u, err := url.Parse(s.config.BaseURL + "/daftar-anime-2/")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL: %v", err)
 	}
 
-	if totalFields > 0 {
-		response.ConfidenceScore = float64(filledFields) / float64(totalFields)
-	} else {
-		response.ConfidenceScore = 0.0
+	q := u.Query()
+	if filter.Title != "" {
+		q.Set("title", filter.Title)
 	}
-
-	// Adjust confidence score based on errors
-	if len(scrapingErrors) > 0 {
-		response.ConfidenceScore *= 0.8
-		response.Message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
-	} else {
-		response.Message = "Data berhasil diambil"
+	if filter.Status != "" {
+		q.Set("status", filter.Status)
 	}
+	if filter.Type != "" {
+		q.Set("type", filter.Type)
+	}
+	if filter.Order != "" {
+		q.Set("order", filter.Order)
+	}
+	for _, genre := range filter.Genres {
+		q.Add("genre[]", genre)
+	}
+	if filter.Page > 1 {
+		q.Set("page", strconv.Itoa(filter.Page))
+	}
+	u.RawQuery = q.Encode()
 
-	// Cache the response
-	s.cache.Set(cacheKey, response)
-
-	return response, nil
+	return u.String(), nil
 }
 
+// searchConfidence calculates the confidence score, message and breakdown
+// for a list of daftar-anime-2 items. Status and type only count as valid
+// when they were read from the page rather than inferred.
+func searchConfidence(items []models.SearchResultItem, scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("tipe", 1, utils.ValidateText).
+		Field("status", 1, utils.ValidateText).
+		Field("skor", 1, utils.ValidateScore).
+		Field("penonton", 0.5, utils.ValidateText).
+		Field("sinopsis", 0.5, utils.ValidateText).
+		Field("genre", 0.5, utils.ValidateText)
+
+	for _, item := range items {
+		scorer.Item(item.DefaultedFields)
+		scorer.Check("judul", item.Judul)
+		scorer.Check("url", item.URL)
+		scorer.Check("anime_slug", item.AnimeSlug)
+		scorer.Check("cover", item.Cover)
+		scorer.Record("tipe", item.TypeSource == utils.SourceScraped, utils.IssueInferredValue)
+		scorer.Record("status", item.StatusSource == utils.SourceScraped, utils.IssueInferredValue)
+		scorer.CheckOptional("skor", item.Skor)
+		scorer.CheckOptional("penonton", item.Penonton)
+		scorer.CheckOptional("sinopsis", item.Sinopsis)
+		scorer.CheckList("genre", item.Genre)
+	}
+
+	return scorer.Result(scrapingErrors)
+}
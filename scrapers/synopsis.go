@@ -0,0 +1,23 @@
+package scrapers
+
+import (
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+// synopsisOf extracts the synopsis held by the first element matching
+// selector inside e, dropping the configured boilerplate paragraphs
+func synopsisOf(cfg *config.Config, e *colly.HTMLElement, selector string) utils.Synopsis {
+	return utils.ExtractSynopsis(e.DOM.Find(selector), cfg.SynopsisBoilerplate)
+}
+
+// synopsisText returns the text and markdown of a synopsis for optional
+// response fields: both nil when the synopsis is empty
+func synopsisText(synopsis utils.Synopsis) (*string, *string) {
+	if len(synopsis.Paragraphs) == 0 {
+		return nil, nil
+	}
+	text, markdown := synopsis.Text(), synopsis.Markdown
+	return &text, &markdown
+}
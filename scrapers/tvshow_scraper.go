@@ -0,0 +1,130 @@
+package scrapers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/nabilulilalbab/winbu.tv/config"
+	"github.com/nabilulilalbab/winbu.tv/models"
+	"github.com/nabilulilalbab/winbu.tv/utils"
+)
+
+type TVShowScraper struct {
+	config *config.Config
+}
+
+func NewTVShowScraper(cfg *config.Config) *TVShowScraper {
+	return &TVShowScraper{config: cfg}
+}
+
+// ScrapeTVShows scrapes one page of the TV show listing.
+func (t *TVShowScraper) ScrapeTVShows(page int) (*models.SeriesListResponse, error) {
+	response, err := scrapeSeriesListing(t.config, t.config.BaseURL+"/tvshow/", page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit tv show page: %w", err)
+	}
+	return response, nil
+}
+
+// scrapeSeriesListing scrapes one page of a portrait series listing such as
+// /tvshow/ or /others/. listingURL must end with a slash.
+func scrapeSeriesListing(cfg *config.Config, listingURL string, page int) (*models.SeriesListResponse, error) {
+	return scrapeListing(cfg, listingURL, "div.ml-item.ml-item-anime.ml-item-latest.ml-potrait", page)
+}
+
+// scrapeListing scrapes one page of any ml-item listing, matching items with
+// itemSelector. listingURL must end with a slash.
+func scrapeListing(cfg *config.Config, listingURL, itemSelector string, page int) (*models.SeriesListResponse, error) {
+	c := utils.CreateCollectorWithRetry(cfg)
+
+	// Extract domain from config for source field
+	domain := utils.ExtractDomain(cfg.BaseURL)
+
+	response := &models.SeriesListResponse{
+		BaseResponse: models.BaseResponse{
+			Source: domain,
+		},
+		Data: []models.SeriesListItem{},
+	}
+
+	var scrapingErrors []string
+	pager := newPageTracker(c, page)
+
+	// Scrape listing items
+	c.OnHTML(itemSelector, func(e *colly.HTMLElement) {
+		item := models.SeriesListItem{
+			Judul:     utils.CleanText(e.ChildText(".judul")),
+			URL:       e.ChildAttr("a.ml-mask", "href"),
+			AnimeSlug: utils.ExtractSlugFromURL(e.ChildAttr("a.ml-mask", "href")),
+			Episode:   optionalString(e.ChildText(".mli-episode")),
+			Rilis:     optionalString(e.ChildText(".mli-waktu")),
+			Views:     optionalString(e.ChildText(".mli-info .mli-mvi")),
+			Skor:      optionalString(e.ChildText("span.mli-mvi[style*='text-align:right']")),
+			Cover:     imageURL(cfg, e, "img.mli-thumb"),
+		}
+		item.EpisodeNumber = utils.EpisodeNumberValue(stringValue(item.Episode))
+		item.ViewsCount = utils.ViewsCount(stringValue(item.Views))
+		item.ScoreValue = utils.ScoreValue(stringValue(item.Skor))
+
+		if item.Judul != "" && item.URL != "" {
+			response.Data = append(response.Data, item)
+		}
+	})
+
+	// Error handling
+	c.OnError(func(r *colly.Response, err error) {
+		scrapingErrors = append(scrapingErrors, fmt.Sprintf("Error scraping %s: %v", r.Request.URL, err))
+	})
+
+	// Build URL with pagination
+	url := listingURL
+	if page > 1 {
+		url += "page/" + strconv.Itoa(page) + "/"
+	}
+
+	// Visit the page
+	if err := c.Visit(url); err != nil && !pager.notFound {
+		return nil, err
+	}
+
+	pagination, err := pager.result(len(response.Data))
+	if err != nil {
+		return nil, err
+	}
+	response.Pagination = pagination
+
+	response.ConfidenceScore, response.Message, response.ConfidenceBreakdown = seriesListConfidence(response.Data, scrapingErrors)
+
+	fillSeriesListPlaceholders(cfg, response.Data)
+
+	return response, nil
+}
+
+// seriesListConfidence calculates the confidence score, message and
+// breakdown for a list of SeriesListItem entries.
+func seriesListConfidence(items []models.SeriesListItem, scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	scorer := utils.NewConfidenceScorer().
+		Field("judul", 3, utils.ValidateText).
+		Field("url", 3, utils.ValidateURL).
+		Field("anime_slug", 1, utils.ValidateText).
+		Field("cover", 2, utils.ValidateCoverURL).
+		Field("episode", 1, utils.ValidateText).
+		Field("rilis", 1, utils.ValidateText).
+		Field("skor", 1, utils.ValidateScore).
+		Field("views", 0.5, utils.ValidateText)
+
+	for _, item := range items {
+		scorer.Item(item.DefaultedFields)
+		scorer.Check("judul", item.Judul)
+		scorer.Check("url", item.URL)
+		scorer.Check("anime_slug", item.AnimeSlug)
+		scorer.Check("cover", item.Cover)
+		scorer.CheckOptional("episode", item.Episode)
+		scorer.CheckOptional("rilis", item.Rilis)
+		scorer.CheckOptional("skor", item.Skor)
+		scorer.CheckOptional("views", item.Views)
+	}
+
+	return scorer.Result(scrapingErrors)
+}
@@ -0,0 +1,286 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/nabilulilalbab/winbu.tv/models"
+)
+
+// Reasons a field value fails validation, as reported in the breakdown
+const (
+	IssueMissing       = "missing"
+	IssuePlaceholder   = "placeholder"
+	IssueInvalidURL    = "invalid_url"
+	IssueNotANumber    = "not_a_number"
+	IssueOutOfRange    = "out_of_range"
+	IssueNotAnImage    = "not_an_image"
+	IssueInferredValue = "inferred"
+)
+
+// errorPenalty is the multiplier applied when a scrape reported errors
+const errorPenalty = 0.8
+
+// placeholderTexts are values that stand in for missing data, either on the
+// site itself or from the placeholders v1 responses fill in
+var placeholderTexts = map[string]bool{
+	"-":                      true,
+	"--":                     true,
+	"?":                      true,
+	"n/a":                    true,
+	"none":                   true,
+	"null":                   true,
+	"unknown":                true,
+	"tba":                    true,
+	"tbd":                    true,
+	"coming soon":            true,
+	"0 views":                true,
+	"unknown series":         true,
+	"winbutv admin":          true,
+	"10,000+ views":          true,
+	"10,000+ viewers":        true,
+	"15,000+ viewers":        true,
+	"1,000 users":            true,
+	"1,234 users":            true,
+	"no synopsis available.": true,
+	"no synopsis available for this episode.":                               true,
+	"an exciting movie with great storyline and amazing characters.":        true,
+	"an exciting story with great characters and amazing plot development.": true,
+}
+
+// placeholderImageMarkers appear in the file names of lazy-load and
+// "no image" stand-ins
+var placeholderImageMarkers = []string{
+	"placeholder", "no-image", "noimage", "no_image", "blank.gif", "blank.png",
+	"loading.gif", "lazy.", "default-poster", "1x1.",
+}
+
+var imageExtensions = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".webp": true, ".gif": true, ".avif": true,
+}
+
+// IsPlaceholderText reports whether text is a known stand-in for missing data
+func IsPlaceholderText(text string) bool {
+	return placeholderTexts[strings.ToLower(CleanText(text))]
+}
+
+// IsPlaceholderImage reports whether an image URL points at a lazy-load or
+// "no image" stand-in rather than real artwork
+func IsPlaceholderImage(imageURL string) bool {
+	lower := strings.ToLower(imageURL)
+	if strings.HasPrefix(lower, "data:") {
+		return true
+	}
+	for _, marker := range placeholderImageMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// A FieldValidator reports whether a scraped value is usable and, if not,
+// the reason for the confidence breakdown.
+type FieldValidator func(value string) (bool, string)
+
+// ValidateText accepts any text that is not blank or a placeholder
+func ValidateText(value string) (bool, string) {
+	if CleanText(value) == "" {
+		return false, IssueMissing
+	}
+	if IsPlaceholderText(value) {
+		return false, IssuePlaceholder
+	}
+	return true, ""
+}
+
+// ValidateURL accepts absolute http(s) URLs
+func ValidateURL(value string) (bool, string) {
+	if strings.TrimSpace(value) == "" {
+		return false, IssueMissing
+	}
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false, IssueInvalidURL
+	}
+	return true, ""
+}
+
+// ValidateScore accepts ratings between 0 and 10, such as "8.71" or "7,5"
+func ValidateScore(value string) (bool, string) {
+	if CleanText(value) == "" {
+		return false, IssueMissing
+	}
+	score, ok := parseScoreNumber(value)
+	if !ok {
+		return false, IssueNotANumber
+	}
+	if score < 0 || score > 10 {
+		return false, IssueOutOfRange
+	}
+	return true, ""
+}
+
+// ValidateCoverURL accepts absolute URLs that look like real artwork: an
+// image file or an upload path, and not a placeholder image
+func ValidateCoverURL(value string) (bool, string) {
+	if ok, issue := ValidateURL(value); !ok {
+		return false, issue
+	}
+	if IsPlaceholderImage(value) {
+		return false, IssuePlaceholder
+	}
+	u, _ := url.Parse(strings.TrimSpace(value))
+	if !imageExtensions[strings.ToLower(path.Ext(u.Path))] && !strings.Contains(u.Path, "/wp-content/uploads/") {
+		return false, IssueNotAnImage
+	}
+	return true, ""
+}
+
+// ConfidenceScorer computes a weighted, field-level confidence score. Each
+// field is declared with a weight and a validator, every scraped value is
+// checked against its validator, and the score is the weighted average of
+// the share of valid values per field. Fields that were never checked do not
+// count.
+type ConfidenceScorer struct {
+	fields    []*fieldTally
+	byName    map[string]*fieldTally
+	defaulted map[string]bool
+}
+
+type fieldTally struct {
+	name     string
+	weight   float64
+	validate FieldValidator
+	checked  int
+	valid    int
+	issues   map[string]int
+}
+
+func NewConfidenceScorer() *ConfidenceScorer {
+	return &ConfidenceScorer{byName: make(map[string]*fieldTally)}
+}
+
+// Field declares a field. Fields are reported in the order they are declared.
+func (s *ConfidenceScorer) Field(name string, weight float64, validate FieldValidator) *ConfidenceScorer {
+	tally := &fieldTally{name: name, weight: weight, validate: validate}
+	s.fields = append(s.fields, tally)
+	s.byName[name] = tally
+	return s
+}
+
+// Item starts scoring the next item. Fields listed in defaulted hold
+// placeholder values and count as invalid whatever they contain.
+func (s *ConfidenceScorer) Item(defaulted []string) {
+	s.defaulted = make(map[string]bool, len(defaulted))
+	for _, field := range defaulted {
+		s.defaulted[field] = true
+	}
+}
+
+// Check validates one value of a declared field
+func (s *ConfidenceScorer) Check(name, value string) {
+	tally, ok := s.byName[name]
+	if !ok {
+		return
+	}
+	valid, issue := tally.validate(value)
+	s.Record(name, valid, issue)
+}
+
+// CheckOptional validates a field that is nil when the page left it blank
+func (s *ConfidenceScorer) CheckOptional(name string, value *string) {
+	if value == nil {
+		s.Record(name, false, IssueMissing)
+		return
+	}
+	s.Check(name, *value)
+}
+
+// CheckList validates a list field; it is valid when it has entries and
+// every entry passes the field's validator
+func (s *ConfidenceScorer) CheckList(name string, values []string) {
+	tally, ok := s.byName[name]
+	if !ok {
+		return
+	}
+	if len(values) == 0 {
+		s.Record(name, false, IssueMissing)
+		return
+	}
+	for _, value := range values {
+		if valid, issue := tally.validate(value); !valid {
+			s.Record(name, false, issue)
+			return
+		}
+	}
+	s.Record(name, true, "")
+}
+
+// Record counts a value whose validity the caller decided, e.g. whether a
+// list has entries or a status was read from the page rather than inferred
+func (s *ConfidenceScorer) Record(name string, valid bool, issue string) {
+	tally, ok := s.byName[name]
+	if !ok {
+		return
+	}
+	if s.defaulted[name] {
+		valid, issue = false, IssuePlaceholder
+	}
+	tally.checked++
+	if valid {
+		tally.valid++
+		return
+	}
+	if tally.issues == nil {
+		tally.issues = make(map[string]int)
+	}
+	tally.issues[issue]++
+}
+
+// Result returns the confidence score, the response message and the
+// breakdown explaining the score. Scrapes that reported errors are scored
+// down by errorPenalty.
+func (s *ConfidenceScorer) Result(scrapingErrors []string) (float64, string, *models.ConfidenceBreakdown) {
+	breakdown := &models.ConfidenceBreakdown{
+		ErrorCount:   len(scrapingErrors),
+		ErrorPenalty: 1.0,
+		Fields:       []models.FieldConfidence{},
+	}
+
+	var weighted, totalWeight float64
+	for _, tally := range s.fields {
+		field := models.FieldConfidence{
+			Field:   tally.name,
+			Weight:  tally.weight,
+			Checked: tally.checked,
+			Valid:   tally.valid,
+			Issues:  tally.issues,
+		}
+		if tally.checked > 0 {
+			field.Score = RoundScore(float64(tally.valid) / float64(tally.checked))
+			weighted += tally.weight * float64(tally.valid) / float64(tally.checked)
+			totalWeight += tally.weight
+		}
+		breakdown.Fields = append(breakdown.Fields, field)
+	}
+	if totalWeight > 0 {
+		breakdown.FieldScore = RoundScore(weighted / totalWeight)
+	}
+
+	message := "Data berhasil diambil"
+	if len(scrapingErrors) > 0 {
+		breakdown.ErrorPenalty = errorPenalty
+		message = fmt.Sprintf("Scraped with %d errors", len(scrapingErrors))
+	}
+
+	return RoundScore(breakdown.FieldScore * breakdown.ErrorPenalty), message, breakdown
+}
+
+// RoundScore rounds a score or rate to three decimals
+func RoundScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
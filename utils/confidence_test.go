@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/nabilulilalbab/winbu.tv/models"
+)
+
+// fieldResult returns the breakdown entry for field
+func fieldResult(t *testing.T, breakdown *models.ConfidenceBreakdown, field string) models.FieldConfidence {
+	t.Helper()
+	for _, f := range breakdown.Fields {
+		if f.Field == field {
+			return f
+		}
+	}
+	t.Fatalf("Failed to find field %q in breakdown", field)
+	return models.FieldConfidence{}
+}
+
+func TestConfidenceScorerWeighting(t *testing.T) {
+	scorer := NewConfidenceScorer().
+		Field("judul", 3, ValidateText).
+		Field("url", 1, ValidateURL).
+		Field("views", 5, ValidateText)
+
+	scorer.Item(nil)
+	scorer.Check("judul", "Dandadan")
+	scorer.Check("url", "https://winbu.net/anime/dandadan/")
+	scorer.Item(nil)
+	scorer.Check("judul", "One Piece")
+	scorer.Check("url", "/anime/one-piece/")
+
+	// judul 2/2 at weight 3, url 1/2 at weight 1; views was never checked
+	// and does not count
+	score, message, breakdown := scorer.Result(nil)
+	if score != 0.875 {
+		t.Errorf("Result() score = %v; want 0.875", score)
+	}
+	if message != "Data berhasil diambil" {
+		t.Errorf("Result() message = %q; want %q", message, "Data berhasil diambil")
+	}
+	if breakdown.FieldScore != 0.875 || breakdown.ErrorPenalty != 1 {
+		t.Errorf("Result() field score = %v, penalty = %v; want 0.875, 1", breakdown.FieldScore, breakdown.ErrorPenalty)
+	}
+
+	url := fieldResult(t, breakdown, "url")
+	if url.Checked != 2 || url.Valid != 1 || url.Score != 0.5 || url.Issues[IssueInvalidURL] != 1 {
+		t.Errorf("url = %+v; want 1 of 2 valid with one %s issue", url, IssueInvalidURL)
+	}
+	if views := fieldResult(t, breakdown, "views"); views.Checked != 0 || views.Score != 0 {
+		t.Errorf("views = %+v; want unchecked", views)
+	}
+
+	if got := []string{breakdown.Fields[0].Field, breakdown.Fields[1].Field, breakdown.Fields[2].Field}; got[0] != "judul" || got[1] != "url" || got[2] != "views" {
+		t.Errorf("breakdown order = %v; want declaration order", got)
+	}
+}
+
+func TestConfidenceScorerDefaultedFields(t *testing.T) {
+	scorer := NewConfidenceScorer().
+		Field("skor", 1, ValidateScore).
+		Field("genres", 1, ValidateText)
+
+	// A placeholder counts as invalid even though it would pass validation
+	scorer.Item([]string{"skor", "genres"})
+	scorer.Check("skor", "7.5")
+	scorer.CheckList("genres", []string{"Action", "Drama"})
+
+	// The next item starts with no defaulted fields
+	scorer.Item(nil)
+	scorer.Check("skor", "8.71")
+	scorer.CheckList("genres", []string{"Action"})
+
+	score, _, breakdown := scorer.Result(nil)
+	if score != 0.5 {
+		t.Errorf("Result() score = %v; want 0.5", score)
+	}
+	for _, name := range []string{"skor", "genres"} {
+		field := fieldResult(t, breakdown, name)
+		if field.Checked != 2 || field.Valid != 1 || field.Issues[IssuePlaceholder] != 1 {
+			t.Errorf("%s = %+v; want 1 of 2 valid with one %s issue", name, field, IssuePlaceholder)
+		}
+	}
+}
+
+func TestConfidenceScorerOptionalFields(t *testing.T) {
+	episode := "Episode 6"
+	placeholder := "Unknown"
+
+	tests := []struct {
+		name  string
+		check func(s *ConfidenceScorer)
+		valid bool
+		issue string
+	}{
+		{"optional present", func(s *ConfidenceScorer) { s.CheckOptional("episode", &episode) }, true, ""},
+		{"optional nil", func(s *ConfidenceScorer) { s.CheckOptional("episode", nil) }, false, IssueMissing},
+		{"optional placeholder", func(s *ConfidenceScorer) { s.CheckOptional("episode", &placeholder) }, false, IssuePlaceholder},
+		{"list with entries", func(s *ConfidenceScorer) { s.CheckList("episode", []string{"Action", "Drama"}) }, true, ""},
+		{"empty list", func(s *ConfidenceScorer) { s.CheckList("episode", nil) }, false, IssueMissing},
+		{"list with a placeholder entry", func(s *ConfidenceScorer) { s.CheckList("episode", []string{"Action", "Unknown"}) }, false, IssuePlaceholder},
+		{"recorded", func(s *ConfidenceScorer) { s.Record("episode", false, IssueInferredValue) }, false, IssueInferredValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scorer := NewConfidenceScorer().Field("episode", 1, ValidateText)
+			tt.check(scorer)
+			// Undeclared fields are ignored
+			scorer.Check("undeclared", "value")
+
+			_, _, breakdown := scorer.Result(nil)
+			if len(breakdown.Fields) != 1 {
+				t.Fatalf("breakdown has %d fields; want 1", len(breakdown.Fields))
+			}
+			field := breakdown.Fields[0]
+			if field.Checked != 1 || (field.Valid == 1) != tt.valid {
+				t.Errorf("episode = %+v; want valid %v", field, tt.valid)
+			}
+			if !tt.valid && field.Issues[tt.issue] != 1 {
+				t.Errorf("episode issues = %v; want one %s", field.Issues, tt.issue)
+			}
+		})
+	}
+}
+
+func TestConfidenceScorerErrors(t *testing.T) {
+	newScorer := func() *ConfidenceScorer {
+		scorer := NewConfidenceScorer().Field("judul", 1, ValidateText)
+		scorer.Check("judul", "Dandadan")
+		scorer.Check("judul", "")
+		return scorer
+	}
+
+	score, message, breakdown := newScorer().Result([]string{"Error scraping page 2: timeout", "Error scraping page 3: timeout"})
+	if score != 0.4 {
+		t.Errorf("Result() score = %v; want 0.4", score)
+	}
+	if message != "Scraped with 2 errors" {
+		t.Errorf("Result() message = %q; want %q", message, "Scraped with 2 errors")
+	}
+	if breakdown.FieldScore != 0.5 || breakdown.ErrorPenalty != errorPenalty || breakdown.ErrorCount != 2 {
+		t.Errorf("Result() breakdown = %+v; want field score 0.5, penalty %v, 2 errors", breakdown, errorPenalty)
+	}
+
+	if score, _, _ := newScorer().Result(nil); score != 0.5 {
+		t.Errorf("Result() without errors score = %v; want 0.5", score)
+	}
+
+	// Nothing checked scores zero, errors or not
+	if score, _, _ := NewConfidenceScorer().Field("judul", 1, ValidateText).Result([]string{"Error"}); score != 0 {
+		t.Errorf("Result() with nothing checked score = %v; want 0", score)
+	}
+}
@@ -0,0 +1,65 @@
+package utils
+
+import "strings"
+
+// Provenance values for fields that are either read from the page or
+// derived from other signals such as the URL path.
+const (
+	SourceScraped  = "scraped"
+	SourceInferred = "inferred"
+)
+
+// contentTypes maps badge and info-row text to a canonical content type
+var contentTypes = map[string]string{
+	"tv":        "TV",
+	"tv series": "TV",
+	"series":    "Series",
+	"movie":     "Movie",
+	"film":      "Movie",
+	"ova":       "OVA",
+	"ona":       "ONA",
+	"special":   "Special",
+	"specials":  "Special",
+	"donghua":   "Donghua",
+}
+
+// airingStatuses maps badge and info-row text to a canonical airing status
+var airingStatuses = map[string]string{
+	"ongoing":          "Ongoing",
+	"on going":         "Ongoing",
+	"currently airing": "Ongoing",
+	"sedang tayang":    "Ongoing",
+	"completed":        "Completed",
+	"complete":         "Completed",
+	"finished airing":  "Completed",
+	"tamat":            "Completed",
+	"selesai":          "Completed",
+	"upcoming":         "Upcoming",
+	"not yet aired":    "Upcoming",
+}
+
+// NormalizeContentType returns the canonical content type for a badge or
+// info value such as "TV", "Movie" or "OVA", or "" when the text is not a
+// known type.
+func NormalizeContentType(text string) string {
+	return contentTypes[strings.ToLower(CleanText(text))]
+}
+
+// NormalizeAiringStatus returns "Ongoing", "Completed" or "Upcoming" for a
+// badge or info value, or "" when the text is not a known status.
+func NormalizeAiringStatus(text string) string {
+	return airingStatuses[strings.ToLower(CleanText(text))]
+}
+
+// InferContentType guesses the content type from the URL path. It is the
+// fallback when the page shows no type badge.
+func InferContentType(pageURL string) string {
+	switch {
+	case strings.Contains(pageURL, "/film/"):
+		return "Movie"
+	case strings.Contains(pageURL, "/series/"):
+		return "Series"
+	default:
+		return "TV"
+	}
+}
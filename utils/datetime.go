@@ -0,0 +1,253 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WIB is Western Indonesia Time (UTC+7), the timezone the site publishes in.
+var WIB = time.FixedZone("WIB", 7*60*60)
+
+// relativeTimeRe matches "<n> <unit>", optionally followed by "lalu" or
+// "yang lalu"
+var relativeTimeRe = regexp.MustCompile(`(?i)\b(\d+)\s*(detik|menit|jam|hari|minggu|bulan|tahun)\b(\s+(?:yang\s+)?lalu\b)?`)
+
+// relativeUnits maps Indonesian time units to their duration, which is also
+// the precision of a parsed value. Months and years vary in length, so they
+// are subtracted on the calendar instead.
+var relativeUnits = map[string]time.Duration{
+	"detik":  time.Second,
+	"menit":  time.Minute,
+	"jam":    time.Hour,
+	"hari":   24 * time.Hour,
+	"minggu": 7 * 24 * time.Hour,
+	"bulan":  30 * 24 * time.Hour,
+	"tahun":  365 * 24 * time.Hour,
+}
+
+// ParseRelativeTime parses Indonesian relative timestamps such as
+// "2 jam yang lalu" or "3 hari" relative to now. It returns the resulting
+// time in WIB and the precision of the value (the size of one unit). The
+// amount must either be followed by "lalu" or make up the whole text, so
+// absolute dates such as "1 Juli 2025 jam 20:00" are not read as relative.
+func ParseRelativeTime(text string, now time.Time) (time.Time, time.Duration, bool) {
+	lower := strings.ToLower(CleanText(text))
+	loc := relativeTimeRe.FindStringSubmatchIndex(lower)
+	if loc == nil {
+		return time.Time{}, 0, false
+	}
+	wholeText := loc[0] == 0 && loc[1] == len(lower)
+	hasLalu := loc[6] >= 0
+	if !wholeText && !hasLalu {
+		return time.Time{}, 0, false
+	}
+
+	amount, err := strconv.Atoi(lower[loc[2]:loc[3]])
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	unitName := lower[loc[4]:loc[5]]
+	unit := relativeUnits[unitName]
+	switch unitName {
+	case "bulan":
+		return monthsBefore(now.In(WIB), amount), unit, true
+	case "tahun":
+		return monthsBefore(now.In(WIB), 12*amount), unit, true
+	}
+	return now.In(WIB).Add(-time.Duration(amount) * unit), unit, true
+}
+
+// monthsBefore returns t moved back n calendar months. A day missing from
+// the target month is clamped to its last day, so one month before 31 March
+// is 28 or 29 February rather than AddDate's normalised 3 March.
+func monthsBefore(t time.Time, n int) time.Time {
+	target := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, -n, 0)
+	day := t.Day()
+	if lastDay := target.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+	return time.Date(target.Year(), target.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// weekdayNames maps Indonesian and English day names to weekdays
+var weekdayNames = map[string]time.Weekday{
+	"senin": time.Monday, "monday": time.Monday,
+	"selasa": time.Tuesday, "tuesday": time.Tuesday,
+	"rabu": time.Wednesday, "wednesday": time.Wednesday,
+	"kamis": time.Thursday, "thursday": time.Thursday,
+	"jumat": time.Friday, "friday": time.Friday,
+	"sabtu": time.Saturday, "saturday": time.Saturday,
+	"minggu": time.Sunday, "ahad": time.Sunday, "sunday": time.Sunday,
+}
+
+var wordRe = regexp.MustCompile(`[a-z']+`)
+
+// ParseWeekday parses a day heading such as "Senin", "Hari Jum'at" or
+// "Sunday:" into a weekday. Text holding more than a short heading is
+// rejected, so sentences that merely mention a day don't match.
+func ParseWeekday(text string) (time.Weekday, bool) {
+	words := wordRe.FindAllString(strings.ToLower(CleanText(text)), -1)
+	if len(words) > 0 && words[0] == "hari" {
+		words = words[1:]
+	}
+	if len(words) == 0 || len(words) > 2 {
+		return 0, false
+	}
+
+	day, ok := weekdayNames[strings.ReplaceAll(words[0], "'", "")]
+	return day, ok
+}
+
+// indonesianMonths maps Indonesian and English month names and their common
+// abbreviations to months
+var indonesianMonths = map[string]time.Month{
+	"januari": time.January, "january": time.January, "jan": time.January,
+	"februari": time.February, "february": time.February, "feb": time.February, "pebruari": time.February,
+	"maret": time.March, "march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"mei": time.May, "may": time.May,
+	"juni": time.June, "june": time.June, "jun": time.June,
+	"juli": time.July, "july": time.July, "jul": time.July,
+	"agustus": time.August, "august": time.August, "agu": time.August, "agt": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"oktober": time.October, "october": time.October, "okt": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November, "nop": time.November,
+	"desember": time.December, "december": time.December, "des": time.December, "dec": time.December,
+}
+
+var (
+	isoDateRe      = regexp.MustCompile(`\b(\d{4})-(\d{1,2})-(\d{1,2})`)
+	numericDateRe  = regexp.MustCompile(`\b(\d{1,2})[/.-](\d{1,2})[/.-](\d{4})\b`)
+	dayMonthYearRe = regexp.MustCompile(`\b(\d{1,2})\s+([a-z]+)\.?,?\s+(\d{4})\b`)
+	monthDayYearRe = regexp.MustCompile(`\b([a-z]+)\.?\s+(\d{1,2}),?\s+(\d{4})\b`)
+	monthYearRe    = regexp.MustCompile(`\b([a-z]+)\.?,?\s+(\d{4})\b`)
+	clockTimeRe    = regexp.MustCompile(`\b(\d{1,2}):(\d{2})\b`)
+	yesterdayWords = []string{"kemarin", "yesterday"}
+	justNowWords   = []string{"baru saja", "barusan", "sekarang", "just now"}
+	todayWords     = []string{"hari ini", "today"}
+)
+
+// ParseDate parses the dates the site prints next to uploads, anchored to
+// now for relative values. It understands relative Indonesian timestamps
+// ("2 jam yang lalu", "kemarin"), ISO dates, day/month/year dates and
+// Indonesian or English month names ("5 Januari 2025", "Oct 5, 2025",
+// "Januari 2025"). Absolute dates are tried before relative ones and without
+// a time of day are midnight WIB.
+func ParseDate(text string, now time.Time) (time.Time, bool) {
+	cleaned := CleanText(text)
+	lower := strings.ToLower(cleaned)
+	if lower == "" {
+		return time.Time{}, false
+	}
+
+	if t, err := time.Parse(time.RFC3339, cleaned); err == nil {
+		return t.In(WIB), true
+	}
+	if t, ok := parseAbsoluteDate(lower); ok {
+		return withClockTime(t, lower), true
+	}
+	if t, _, ok := ParseRelativeTime(lower, now); ok {
+		return t, true
+	}
+
+	now = now.In(WIB)
+	for _, word := range justNowWords {
+		if strings.Contains(lower, word) {
+			return now, true
+		}
+	}
+	for _, word := range yesterdayWords {
+		if strings.Contains(lower, word) {
+			return withClockTime(startOfDay(now).AddDate(0, 0, -1), lower), true
+		}
+	}
+	for _, word := range todayWords {
+		if strings.Contains(lower, word) {
+			return withClockTime(startOfDay(now), lower), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// FormatRFC3339 returns the RFC3339 form of a parsed date, or nil when text
+// is not a date ParseDate understands
+func FormatRFC3339(text string, now time.Time) *string {
+	t, ok := ParseDate(text, now)
+	if !ok {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+func parseAbsoluteDate(text string) (time.Time, bool) {
+	if m := isoDateRe.FindStringSubmatch(text); m != nil {
+		return makeDate(m[1], monthFromNumber(m[2]), m[3])
+	}
+	if m := numericDateRe.FindStringSubmatch(text); m != nil {
+		// Indonesian dates put the day first
+		return makeDate(m[3], monthFromNumber(m[2]), m[1])
+	}
+	if m := dayMonthYearRe.FindStringSubmatch(text); m != nil {
+		if month, ok := indonesianMonths[m[2]]; ok {
+			return makeDate(m[3], month, m[1])
+		}
+	}
+	if m := monthDayYearRe.FindStringSubmatch(text); m != nil {
+		if month, ok := indonesianMonths[m[1]]; ok {
+			return makeDate(m[3], month, m[2])
+		}
+	}
+	if m := monthYearRe.FindStringSubmatch(text); m != nil {
+		if month, ok := indonesianMonths[m[1]]; ok {
+			return makeDate(m[2], month, "1")
+		}
+	}
+	return time.Time{}, false
+}
+
+func monthFromNumber(text string) time.Month {
+	month, _ := strconv.Atoi(text)
+	return time.Month(month)
+}
+
+// makeDate builds midnight WIB of the given date, rejecting impossible dates
+// such as 31 February instead of letting time.Date normalise them
+func makeDate(yearText string, month time.Month, dayText string) (time.Time, bool) {
+	year, err := strconv.Atoi(yearText)
+	if err != nil {
+		return time.Time{}, false
+	}
+	day, err := strconv.Atoi(dayText)
+	if err != nil || month < time.January || month > time.December {
+		return time.Time{}, false
+	}
+
+	t := time.Date(year, month, day, 0, 0, 0, 0, WIB)
+	if t.Day() != day || t.Month() != month {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// withClockTime applies a time of day such as "20:15" found in text to the
+// date day
+func withClockTime(day time.Time, text string) time.Time {
+	m := clockTimeRe.FindStringSubmatch(text)
+	if m == nil {
+		return day
+	}
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+	if hour > 23 || minute > 59 {
+		return day
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, WIB)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, WIB)
+}
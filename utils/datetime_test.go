@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  time.Weekday
+		valid bool
+	}{
+		{"Senin", time.Monday, true},
+		{"  SELASA ", time.Tuesday, true},
+		{"Hari Jum'at", time.Friday, true},
+		{"Jumat:", time.Friday, true},
+		{"Minggu", time.Sunday, true},
+		{"Sunday", time.Sunday, true},
+		{"Sabtu (12)", time.Saturday, true},
+		{"Tayang setiap hari Senin pukul 20:00", 0, false},
+		{"Jadwal Rilis", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseWeekday(tt.text)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("ParseWeekday(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2025, time.July, 10, 15, 30, 0, 0, WIB)
+
+	tests := []struct {
+		text  string
+		want  time.Time
+		valid bool
+	}{
+		{"10 jam", now.Add(-10 * time.Hour), true},
+		{"2 jam yang lalu", now.Add(-2 * time.Hour), true},
+		{"Diupload 5 menit lalu", now.Add(-5 * time.Minute), true},
+		{"3 hari", now.AddDate(0, 0, -3), true},
+		{"baru saja", now, true},
+		{"Kemarin", time.Date(2025, time.July, 9, 0, 0, 0, 0, WIB), true},
+		{"Hari ini 20:15", time.Date(2025, time.July, 10, 20, 15, 0, 0, WIB), true},
+		{"1 Juli 2025 jam 20:00", time.Date(2025, time.July, 1, 20, 0, 0, 0, WIB), true},
+		{"5 Januari 2025", time.Date(2025, time.January, 5, 0, 0, 0, 0, WIB), true},
+		{"05/01/2025", time.Date(2025, time.January, 5, 0, 0, 0, 0, WIB), true},
+		{"2025-01-05", time.Date(2025, time.January, 5, 0, 0, 0, 0, WIB), true},
+		{"Oct 5, 2025", time.Date(2025, time.October, 5, 0, 0, 0, 0, WIB), true},
+		{"Jun 4, 2021", time.Date(2021, time.June, 4, 0, 0, 0, 0, WIB), true},
+		{"Januari 2025", time.Date(2025, time.January, 1, 0, 0, 0, 0, WIB), true},
+		{"2025-07-01T20:00:00+07:00", time.Date(2025, time.July, 1, 20, 0, 0, 0, WIB), true},
+		{"31 Februari 2025", time.Time{}, false},
+		{"Jan 20251", time.Time{}, false},
+		{"Rilis 2 Mei 20256", time.Time{}, false},
+		{"2 bulan yang lalu", time.Date(2025, time.May, 10, 15, 30, 0, 0, WIB), true},
+		{"1 tahun yang lalu", time.Date(2024, time.July, 10, 15, 30, 0, 0, WIB), true},
+		{"Episode 12 tayang 2 minggu sekali", time.Time{}, false},
+		{"N/A", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseDate(tt.text, now)
+			if ok != tt.valid || !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestParseRelativeTimeCalendarUnits(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		text string
+		want time.Time
+	}{
+		{time.Date(2025, time.March, 31, 10, 0, 0, 0, WIB), "1 bulan yang lalu", time.Date(2025, time.February, 28, 10, 0, 0, 0, WIB)},
+		{time.Date(2024, time.March, 31, 10, 0, 0, 0, WIB), "1 bulan yang lalu", time.Date(2024, time.February, 29, 10, 0, 0, 0, WIB)},
+		{time.Date(2025, time.May, 31, 10, 0, 0, 0, WIB), "3 bulan", time.Date(2025, time.February, 28, 10, 0, 0, 0, WIB)},
+		{time.Date(2025, time.January, 15, 10, 0, 0, 0, WIB), "2 bulan lalu", time.Date(2024, time.November, 15, 10, 0, 0, 0, WIB)},
+		{time.Date(2025, time.July, 31, 10, 0, 0, 0, WIB), "1 bulan yang lalu", time.Date(2025, time.June, 30, 10, 0, 0, 0, WIB)},
+		{time.Date(2024, time.February, 29, 10, 0, 0, 0, WIB), "1 tahun yang lalu", time.Date(2023, time.February, 28, 10, 0, 0, 0, WIB)},
+		{time.Date(2025, time.March, 1, 10, 0, 0, 0, WIB), "4 tahun", time.Date(2021, time.March, 1, 10, 0, 0, 0, WIB)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.now.Format("2006-01-02")+" "+tt.text, func(t *testing.T) {
+			got, _, ok := ParseRelativeTime(tt.text, tt.now)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("ParseRelativeTime(%q, %v) = %v, %v; want %v", tt.text, tt.now, got, ok, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Episode kinds reported by ParseEpisodeLabel
+const (
+	EpisodeKindRegular = "episode"
+	EpisodeKindSpecial = "special"
+	EpisodeKindOVA     = "ova"
+	EpisodeKindMovie   = "movie"
+)
+
+var (
+	// episodeTextRe matches "Episode 12", "Eps 12.5", "Ep. 3" or "Episode 12,5".
+	// The second group catches a resolution ("Episode 1080p") or a batch
+	// range ("Eps 1-12"), which are not episode numbers.
+	episodeTextRe = regexp.MustCompile(`(?i)\b(?:episode|eps?)\.?\s*(\d+(?:[.,]\d+)?)(p\b|\s*[-–~]\s*\d+)?`)
+	// episodeSlugRe matches "-episode-12-" and "-episode-12-5-" (12.5) in slugs
+	episodeSlugRe = regexp.MustCompile(`(?i)(?:^|-)(?:episode|eps?)-(\d+)(?:-(\d))?(?:-|$)`)
+	// episodeSlugRangeRe matches batch slugs such as "-episode-1-12-"; a
+	// single digit after the number is a decimal, as in "-episode-12-5-"
+	episodeSlugRangeRe = regexp.MustCompile(`(?i)(?:^|-)(?:episode|eps?)-\d+-\d{2,}(?:-|$)`)
+	// specialSuffixRe matches labels ending in a special keyword with an
+	// optional number, e.g. "Naruto OVA 2" or "One Piece Special"
+	specialSuffixRe = regexp.MustCompile(`(?i)(?:^|[\s:|-])(special|sp|ova|oad|ona|movie)(?:[\s.-]*(\d+(?:[.,]\d+)?))?\s*$`)
+	// specialPrefixRe matches labels starting with a special keyword, e.g.
+	// "OVA 2 - Kepulangan"
+	specialPrefixRe = regexp.MustCompile(`(?i)^(special|sp|ova|oad|ona|movie)\b(?:[\s.-]*(\d+(?:[.,]\d+)?))?`)
+	// bareNumberRe matches labels that are only a number, e.g. "12" or "12.5"
+	bareNumberRe = regexp.MustCompile(`^\d+(?:[.,]\d+)?$`)
+)
+
+// EpisodeLabel is a parsed episode label
+type EpisodeLabel struct {
+	Kind      string
+	Number    float64
+	HasNumber bool
+}
+
+// String formats the label for display: "12.5" for regular episodes,
+// "OVA 2" or "Special" for everything else.
+func (l EpisodeLabel) String() string {
+	number := strconv.FormatFloat(l.Number, 'f', -1, 64)
+	if l.Kind == EpisodeKindRegular {
+		if l.HasNumber {
+			return number
+		}
+		return ""
+	}
+
+	kind := strings.ToUpper(l.Kind[:1]) + l.Kind[1:]
+	if l.Kind == EpisodeKindOVA {
+		kind = "OVA"
+	}
+	if l.HasNumber {
+		return kind + " " + number
+	}
+	return kind
+}
+
+// ParseEpisodeLabel parses an episode link title such as "Episode 12.5",
+// "OVA 2" or "Naruto Special". An explicit episode number in the title, or
+// failing that in the slug (e.g. "naruto-episode-12-5"), always makes a
+// regular episode, so series named like "Special A" aren't mistaken for
+// specials. Special keywords only count at the start or end of the title.
+func ParseEpisodeLabel(title, slug string) EpisodeLabel {
+	label := EpisodeLabel{Kind: EpisodeKindRegular}
+	title = CleanText(title)
+
+	if number, ok := ParseEpisodeNumber(title); ok {
+		label.Number, label.HasNumber = number, true
+		return label
+	}
+
+	if match := episodeSlugRe.FindStringSubmatch(slug); match != nil && !episodeSlugRangeRe.MatchString(slug) {
+		number := match[1]
+		if match[2] != "" {
+			number += "." + match[2]
+		}
+		if label.Number, label.HasNumber = parseDecimal(number); label.HasNumber {
+			return label
+		}
+	}
+
+	for _, re := range []*regexp.Regexp{specialSuffixRe, specialPrefixRe} {
+		if match := re.FindStringSubmatch(title); match != nil {
+			label.Kind = normalizeEpisodeKind(match[1])
+			if match[2] != "" {
+				label.Number, label.HasNumber = parseDecimal(match[2])
+			}
+			return label
+		}
+	}
+
+	return label
+}
+
+// ParseEpisodeNumber extracts the episode number from labels such as
+// "Episode 6", "Eps 12.5" or a bare "12". Resolutions ("Episode 1080p") and
+// batch ranges ("Eps 1-12") are not episode numbers and are rejected.
+func ParseEpisodeNumber(text string) (float64, bool) {
+	text = CleanText(text)
+	if bareNumberRe.MatchString(text) {
+		return parseDecimal(text)
+	}
+
+	match := episodeTextRe.FindStringSubmatch(text)
+	if match == nil || match[2] != "" {
+		return 0, false
+	}
+	return parseDecimal(match[1])
+}
+
+// normalizeEpisodeKind maps the keywords the site uses for specials to an
+// episode kind.
+func normalizeEpisodeKind(keyword string) string {
+	switch strings.ToLower(keyword) {
+	case "ova", "oad", "ona":
+		return EpisodeKindOVA
+	case "movie":
+		return EpisodeKindMovie
+	default:
+		return EpisodeKindSpecial
+	}
+}
+
+// parseDecimal parses numbers using either "." or "," as decimal separator.
+func parseDecimal(text string) (float64, bool) {
+	number, err := strconv.ParseFloat(strings.Replace(text, ",", ".", 1), 64)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+var (
+	// seasonTextRe matches "Season 2", "Season 02" and "S2", but not a lone
+	// "s" before a number such as "Girls 2"
+	seasonTextRe = regexp.MustCompile(`(?i)\b(?:season\s*0*(\d+)|s0*(\d+))\b`)
+	// seasonOrdinalRe matches "2nd Season" and "3rd Season"
+	seasonOrdinalRe = regexp.MustCompile(`(?i)\b(\d+)(?:st|nd|rd|th)\s+season\b`)
+	// seasonRomanRe matches a roman numeral sequel marker ending a title,
+	// e.g. "Mob Psycho 100 II"
+	seasonRomanRe = regexp.MustCompile(`(?i)\s(ii|iii|iv)\s*$`)
+)
+
+var romanSeasons = map[string]int{"ii": 2, "iii": 3, "iv": 4}
+
+// volumeRomanRe matches roman numerals that number a volume, part or
+// chapter rather than a season, e.g. "Vol. II"
+var volumeRomanRe = regexp.MustCompile(`(?i)\b(?:vol(?:ume)?|part|chapter|bab)\.?\s*(?:ii|iii|iv)\s*$`)
+
+// ParseSeasonNumber extracts the season number from titles such as
+// "Season 2", "2nd Season", "S3" or "Mob Psycho 100 II".
+func ParseSeasonNumber(text string) (int, bool) {
+	text = CleanText(text)
+	for _, re := range []*regexp.Regexp{seasonTextRe, seasonOrdinalRe} {
+		if match := re.FindStringSubmatch(text); match != nil {
+			number, err := strconv.Atoi(strings.Join(match[1:], ""))
+			if err == nil {
+				return number, true
+			}
+		}
+	}
+	if match := seasonRomanRe.FindStringSubmatch(text); match != nil && !volumeRomanRe.MatchString(text) {
+		return romanSeasons[strings.ToLower(match[1])], true
+	}
+	return 0, false
+}
+
+var (
+	// franchiseMarkerRe matches the parts of a title that tell sibling
+	// seasons, parts and spin-offs apart
+	franchiseMarkerRe = regexp.MustCompile(`(?i)\b(?:the\s+)?final\s+season\b|\b\d+(?:st|nd|rd|th)\s+season\b|\bseason\s*\d+\b|\bs\d+\b|\bpart\s*\d+\b|\bcour\s*\d+\b|\bmovie(?:\s*\d+)?\b|\bova\b|\bspecials?\b|\((?:\d{4}|tv)\)`)
+	// franchiseSequelRe matches a sequel number ending a title, either roman
+	// ("Overlord IV") or a small number ("Overlord 4"). Larger numbers are
+	// part of the title, as in "Mob Psycho 100" or "86".
+	franchiseSequelRe = regexp.MustCompile(`\s(?:ii|iii|iv|[2-9]|1\d|20)$`)
+	// franchiseSubtitleRe matches subtitle separators such as ": " and " - "
+	franchiseSubtitleRe = regexp.MustCompile(`\s*(?::\s|\s[-–]\s).*$`)
+	nonAlphanumericRe   = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// FranchiseBaseTitle reduces a title to a comparable form without the
+// markers that tell seasons, parts and spin-offs apart, e.g.
+// "Shingeki no Kyojin Season 3: Part 2" and "Shingeki no Kyojin: The Final
+// Season" both become "shingeki no kyojin". Subtitles and trailing numbers
+// are kept, since they are often part of the name ("Gintama 2", "Boruto:
+// Naruto Next Generations"); SameFranchise only drops them when a sibling
+// title shares the rest.
+func FranchiseBaseTitle(title string) string {
+	return normalizeFranchiseTitle(strings.ToLower(CleanText(title)))
+}
+
+// FranchiseStem is FranchiseBaseTitle without the subtitle and the sequel
+// number, e.g. "Mob Psycho 100 II" becomes "mob psycho 100" and "Dr. Stone:
+// New World" becomes "dr stone". It is the broadest title to search sibling
+// entries by.
+func FranchiseStem(title string) string {
+	stem := franchiseSubtitleRe.ReplaceAllString(strings.ToLower(CleanText(title)), "")
+	return CleanText(franchiseSequelRe.ReplaceAllString(normalizeFranchiseTitle(stem), ""))
+}
+
+// SameFranchise reports whether two titles belong to the same franchise.
+// Their base titles must match, or one title must be the other plus a
+// subtitle or sequel number ("Overlord" and "Overlord IV"), or both must
+// extend the same stem ("Boruto: Naruto Next Generations" and "Boruto: Two
+// Blue Vortex").
+func SameFranchise(a, b string) bool {
+	baseA, baseB := FranchiseBaseTitle(a), FranchiseBaseTitle(b)
+	if baseA == "" || baseB == "" {
+		return false
+	}
+	if baseA == baseB {
+		return true
+	}
+	stemA, stemB := FranchiseStem(a), FranchiseStem(b)
+	if stemA == "" || stemB == "" {
+		return false
+	}
+	return stemA == baseB || stemB == baseA || (stemA == stemB && stemA != baseA && stemB != baseB)
+}
+
+func normalizeFranchiseTitle(title string) string {
+	title = franchiseMarkerRe.ReplaceAllString(title, " ")
+	return CleanText(nonAlphanumericRe.ReplaceAllString(title, " "))
+}
@@ -0,0 +1,182 @@
+package utils
+
+import "testing"
+
+func TestParseEpisodeLabel(t *testing.T) {
+	tests := []struct {
+		title     string
+		slug      string
+		kind      string
+		number    float64
+		hasNumber bool
+	}{
+		{"Episode 6", "okiraku-ryoushu-episode-6", EpisodeKindRegular, 6, true},
+		{"Eps 12.5", "", EpisodeKindRegular, 12.5, true},
+		{"Episode 12,5", "", EpisodeKindRegular, 12.5, true},
+		{"13", "", EpisodeKindRegular, 13, true},
+		{"", "naruto-episode-12-5", EpisodeKindRegular, 12.5, true},
+		{"Special A Episode 5", "special-a-episode-5", EpisodeKindRegular, 5, true},
+		{"Special A", "special-a-episode-5", EpisodeKindRegular, 5, true},
+		{"Ona no Ko Episode 3", "", EpisodeKindRegular, 3, true},
+		{"Spy x Family Episode 2", "", EpisodeKindRegular, 2, true},
+		{"Naruto OVA 2", "", EpisodeKindOVA, 2, true},
+		{"OVA 2 - Kepulangan", "", EpisodeKindOVA, 2, true},
+		{"One Piece Special", "", EpisodeKindSpecial, 0, false},
+		{"Naruto SP", "", EpisodeKindSpecial, 0, false},
+		{"Kimetsu no Yaiba Movie", "", EpisodeKindMovie, 0, false},
+		{"Movie Spesial Musim Panas", "", EpisodeKindMovie, 0, false},
+		{"Mobile Suit Gundam: The Movie Collection", "", EpisodeKindRegular, 0, false},
+		{"Series", "", EpisodeKindRegular, 0, false},
+		{"Episode 1080p", "", EpisodeKindRegular, 0, false},
+		{"Episode 7 1080p", "", EpisodeKindRegular, 7, true},
+		{"Eps 1-12", "", EpisodeKindRegular, 0, false},
+		{"Eps 1 - 12 (Batch)", "", EpisodeKindRegular, 0, false},
+		{"Batch", "naruto-episode-1-12-batch", EpisodeKindRegular, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title+"|"+tt.slug, func(t *testing.T) {
+			got := ParseEpisodeLabel(tt.title, tt.slug)
+			if got.Kind != tt.kind || got.Number != tt.number || got.HasNumber != tt.hasNumber {
+				t.Errorf("ParseEpisodeLabel(%q, %q) = %+v; want {Kind:%s Number:%v HasNumber:%v}",
+					tt.title, tt.slug, got, tt.kind, tt.number, tt.hasNumber)
+			}
+		})
+	}
+}
+
+func TestEpisodeLabelString(t *testing.T) {
+	tests := []struct {
+		label EpisodeLabel
+		want  string
+	}{
+		{EpisodeLabel{Kind: EpisodeKindRegular, Number: 12.5, HasNumber: true}, "12.5"},
+		{EpisodeLabel{Kind: EpisodeKindRegular}, ""},
+		{EpisodeLabel{Kind: EpisodeKindOVA, Number: 2, HasNumber: true}, "OVA 2"},
+		{EpisodeLabel{Kind: EpisodeKindSpecial}, "Special"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.label.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q; want %q", tt.label, got, tt.want)
+		}
+	}
+}
+
+func TestParseSeasonNumber(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  int
+		valid bool
+	}{
+		{"Season 2", 2, true},
+		{"Dr. Stone Season 03", 3, true},
+		{"Shingeki no Kyojin S2", 2, true},
+		{"Boku no Hero Academia 5th Season", 5, true},
+		{"Mob Psycho 100 II", 2, true},
+		{"Mob Psycho 100 III", 3, true},
+		{"Mob Psycho 100", 0, false},
+		{"Girls s 2", 0, false},
+		{"Vol. II", 0, false},
+		{"Kaguya-sama Volume III", 0, false},
+		{"Gintama 2", 0, false},
+		{"86", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseSeasonNumber(tt.text)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("ParseSeasonNumber(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestFranchiseBaseTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Shingeki no Kyojin", "shingeki no kyojin"},
+		{"Shingeki no Kyojin Season 3: Part 2", "shingeki no kyojin"},
+		{"Shingeki no Kyojin: The Final Season", "shingeki no kyojin"},
+		{"Mob Psycho 100", "mob psycho 100"},
+		{"Mob Psycho 100 Season 2", "mob psycho 100"},
+		{"86", "86"},
+		{"86 Part 2", "86"},
+		{"Hunter x Hunter (2011)", "hunter x hunter"},
+		{"Boku no Hero Academia 5th Season", "boku no hero academia"},
+		{"Dr. Stone S2", "dr stone"},
+		// Subtitles and trailing numbers can be part of the name
+		{"Gintama 2", "gintama 2"},
+		{"Boruto: Naruto Next Generations", "boruto naruto next generations"},
+		{"Dr. Stone: New World", "dr stone new world"},
+		{"Mob Psycho 100 II", "mob psycho 100 ii"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := FranchiseBaseTitle(tt.title); got != tt.want {
+				t.Errorf("FranchiseBaseTitle(%q) = %q; want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFranchiseStem(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Mob Psycho 100 II", "mob psycho 100"},
+		{"Overlord IV", "overlord"},
+		{"Overlord 4", "overlord"},
+		{"Dr. Stone: New World", "dr stone"},
+		{"Kimetsu no Yaiba Movie: Mugen Ressha-hen", "kimetsu no yaiba"},
+		{"Mob Psycho 100", "mob psycho 100"},
+		{"86", "86"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := FranchiseStem(tt.title); got != tt.want {
+				t.Errorf("FranchiseStem(%q) = %q; want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameFranchise(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Shingeki no Kyojin", "Shingeki no Kyojin: The Final Season", true},
+		{"Mob Psycho 100", "Mob Psycho 100 II", true},
+		{"Mob Psycho 100 II", "Mob Psycho 100 III", true},
+		{"Overlord", "Overlord IV", true},
+		{"Dr. Stone", "Dr. Stone: New World", true},
+		{"Kimetsu no Yaiba", "Kimetsu no Yaiba Movie: Mugen Ressha-hen", true},
+		{"Boruto: Naruto Next Generations", "Boruto: Two Blue Vortex", true},
+		{"Gintama", "Gintama 2", true},
+		// Separate franchises must not merge
+		{"Boruto: Naruto Next Generations", "Naruto", false},
+		{"Gintama 2", "Gintama 3 Kai", false},
+		{"Mob Psycho 100", "Mob Psycho", false},
+		{"86", "86 Eighty", false},
+		{"Dr. Stone", "Dr. Slump", false},
+		{"Overlord", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"|"+tt.b, func(t *testing.T) {
+			if got := SameFranchise(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameFranchise(%q, %q) = %v; want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := SameFranchise(tt.b, tt.a); got != tt.want {
+				t.Errorf("SameFranchise(%q, %q) = %v; want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
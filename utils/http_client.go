@@ -2,6 +2,7 @@ package utils
 
 import (
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/gocolly/colly/v2"
@@ -49,6 +50,10 @@ func CreateCollectorWithRetry(cfg *config.Config) *colly.Collector {
 	// Add retry logic
 	retryCount := 0
 	c.OnError(func(r *colly.Response, err error) {
+		// A missing page will not appear on retry
+		if r.StatusCode == http.StatusNotFound {
+			return
+		}
 		if retryCount < cfg.MaxRetries {
 			retryCount++
 			time.Sleep(time.Duration(retryCount) * time.Second) // Exponential backoff
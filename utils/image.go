@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// lazyImageAttrs hold the real image URL of lazy-loaded images, whose src is
+// often a placeholder until the image scrolls into view
+var lazyImageAttrs = []string{"data-src", "data-lazy-src", "data-original"}
+
+// srcsetAttrs hold comma-separated "url 300w" candidate lists
+var srcsetAttrs = []string{"data-srcset", "data-lazy-srcset", "srcset"}
+
+// resizeSuffixRe matches the WordPress resize suffix of an image file name,
+// e.g. "-300x450" in "cover-300x450.jpg"
+var resizeSuffixRe = regexp.MustCompile(`-(\d+)x(\d+)(\.[A-Za-z0-9]+)$`)
+
+type imageCandidate struct {
+	url   string
+	width int
+}
+
+// ExtractImageURL returns the best image URL of an img element: the
+// highest-resolution candidate among the lazy-load attributes and srcset
+// that is not a placeholder, resolved against baseURL. The src is only used
+// when no such candidate exists, since lazy-loading themes put stand-ins
+// there that IsPlaceholderImage may not know. With stripResize the
+// WordPress resize suffix is removed to get the original. When the element
+// only carries placeholders the src is returned as is, so IsPlaceholderImage
+// still flags it.
+func ExtractImageURL(img *goquery.Selection, baseURL string, stripResize bool) string {
+	if img == nil || img.Length() == 0 {
+		return ""
+	}
+	img = img.First()
+
+	var candidates []imageCandidate
+	for _, attr := range lazyImageAttrs {
+		if value := strings.TrimSpace(img.AttrOr(attr, "")); value != "" {
+			candidates = append(candidates, imageCandidate{url: value, width: imageWidth(value)})
+		}
+	}
+	for _, attr := range srcsetAttrs {
+		candidates = append(candidates, parseSrcset(img.AttrOr(attr, ""))...)
+	}
+
+	// Candidates are in order of preference; a later one only wins when it
+	// is strictly larger
+	best := -1
+	for i, candidate := range candidates {
+		if IsPlaceholderImage(candidate.url) {
+			continue
+		}
+		if best < 0 || candidate.width > candidates[best].width {
+			best = i
+		}
+	}
+	if best >= 0 {
+		return ResolveImageURL(candidates[best].url, baseURL, stripResize)
+	}
+
+	src := strings.TrimSpace(img.AttrOr("src", ""))
+	return ResolveImageURL(src, baseURL, stripResize && !IsPlaceholderImage(src))
+}
+
+// ResolveImageURL resolves a relative or protocol-relative image URL against
+// baseURL and, with stripResize, removes the WordPress resize suffix
+func ResolveImageURL(imageURL, baseURL string, stripResize bool) string {
+	imageURL = strings.TrimSpace(imageURL)
+	if imageURL == "" || strings.HasPrefix(imageURL, "data:") {
+		return imageURL
+	}
+
+	u, err := url.Parse(imageURL)
+	if err != nil {
+		return imageURL
+	}
+	if base, err := url.Parse(baseURL); err == nil && !u.IsAbs() {
+		u = base.ResolveReference(u)
+	}
+	if stripResize {
+		u.Path = resizeSuffixRe.ReplaceAllString(u.Path, "$3")
+	}
+	return u.String()
+}
+
+// parseSrcset parses a srcset attribute such as
+// "a-300x450.jpg 300w, a.jpg 600w"
+func parseSrcset(srcset string) []imageCandidate {
+	// Inline placeholders contain commas of their own
+	if strings.HasPrefix(strings.TrimSpace(srcset), "data:") {
+		return nil
+	}
+
+	var candidates []imageCandidate
+	for _, entry := range strings.Split(srcset, ",") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// Width descriptors beat the resize suffix; density descriptors
+		// ("2x") say nothing about the file, so those rank by suffix
+		candidate := imageCandidate{url: fields[0], width: imageWidth(fields[0])}
+		if len(fields) > 1 && strings.HasSuffix(strings.ToLower(fields[1]), "w") {
+			if width, err := strconv.Atoi(strings.TrimSuffix(strings.ToLower(fields[1]), "w")); err == nil {
+				candidate.width = width
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+// imageWidth estimates the width of an image from its resize suffix. URLs
+// without one are originals and rank above any resized copy.
+func imageWidth(imageURL string) int {
+	u, err := url.Parse(strings.TrimSpace(imageURL))
+	if err != nil {
+		return 0
+	}
+	match := resizeSuffixRe.FindStringSubmatch(u.Path)
+	if match == nil {
+		return math.MaxInt32
+	}
+	width, _ := strconv.Atoi(match[1])
+	return width
+}
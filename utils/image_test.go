@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestExtractImageURL(t *testing.T) {
+	const baseURL = "https://winbu.net"
+
+	tests := []struct {
+		name        string
+		img         string
+		stripResize bool
+		want        string
+	}{
+		{
+			name: "plain src",
+			img:  `<img class="mli-thumb" src="https://winbu.net/wp-content/uploads/2025/07/dandan.jpg">`,
+			want: "https://winbu.net/wp-content/uploads/2025/07/dandan.jpg",
+		},
+		{
+			name: "lazy data-src over placeholder src",
+			img:  `<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-src="/wp-content/uploads/2025/07/dandan.jpg">`,
+			want: "https://winbu.net/wp-content/uploads/2025/07/dandan.jpg",
+		},
+		{
+			name: "data-lazy-src over lazy.gif",
+			img:  `<img src="https://winbu.net/wp-content/themes/x/lazy.gif" data-lazy-src="https://winbu.net/wp-content/uploads/a-300x450.jpg">`,
+			want: "https://winbu.net/wp-content/uploads/a-300x450.jpg",
+		},
+		{
+			name: "largest srcset entry",
+			img:  `<img src="/wp-content/uploads/a-150x225.jpg" srcset="/wp-content/uploads/a-300x450.jpg 300w, /wp-content/uploads/a-600x900.jpg 600w">`,
+			want: "https://winbu.net/wp-content/uploads/a-600x900.jpg",
+		},
+		{
+			name: "original beats resized copies",
+			img:  `<img src="/wp-content/uploads/a-300x450.jpg" data-src="/wp-content/uploads/a.jpg">`,
+			want: "https://winbu.net/wp-content/uploads/a.jpg",
+		},
+		{
+			name: "data-src over unknown stand-in src",
+			img:  `<img src="https://winbu.net/wp-content/themes/x/img/grey.gif" data-src="https://winbu.net/wp-content/uploads/a-300x450.jpg">`,
+			want: "https://winbu.net/wp-content/uploads/a-300x450.jpg",
+		},
+		{
+			name: "srcset over original src",
+			img:  `<img src="/wp-content/uploads/a.jpg" srcset="/wp-content/uploads/a-300x450.jpg 300w">`,
+			want: "https://winbu.net/wp-content/uploads/a-300x450.jpg",
+		},
+		{
+			name: "src when lazy candidates are placeholders",
+			img:  `<img src="/wp-content/uploads/a.jpg" data-src="/wp-content/themes/x/lazy.gif">`,
+			want: "https://winbu.net/wp-content/uploads/a.jpg",
+		},
+		{
+			name:        "strip resize suffix",
+			img:         `<img src="https://winbu.net/wp-content/uploads/a-300x450.jpg">`,
+			stripResize: true,
+			want:        "https://winbu.net/wp-content/uploads/a.jpg",
+		},
+		{
+			name: "protocol-relative src",
+			img:  `<img src="//cdn.winbu.net/wp-content/uploads/a.webp">`,
+			want: "https://cdn.winbu.net/wp-content/uploads/a.webp",
+		},
+		{
+			name: "placeholder only",
+			img:  `<img src="/wp-content/themes/x/no-image.png">`,
+			want: "https://winbu.net/wp-content/themes/x/no-image.png",
+		},
+		{
+			name: "no image",
+			img:  `<span></span>`,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.img))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			got := ExtractImageURL(doc.Find("img"), baseURL, tt.stripResize)
+			if got != tt.want {
+				t.Errorf("ExtractImageURL() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+var (
+	// scoreNumberRe matches a number, capturing a magnitude suffix so counts
+	// such as "1,5 jt" are not read as scores
+	scoreNumberRe = regexp.MustCompile(`(?i)(\d+(?:[.,]\d+)?)(\s*(?:k|rb|ribu|m|jt|juta|b|miliar)\b)?`)
+	// viewsNumberRe matches counts such as "427583", "10,000", "12.034" or
+	// "1,2 rb", with an optional Indonesian or English magnitude suffix
+	viewsNumberRe = regexp.MustCompile(`(\d+(?:[.,]\d+)*)\s*(k|rb|ribu|m|jt|juta|b|miliar)?\b`)
+	// viewsKeywordAfterRe and viewsKeywordBeforeRe match a views keyword
+	// right after or right before a count, e.g. "12.034 Views", "1.234 kali
+	// ditonton" or "Penonton: 427583"
+	viewsKeywordAfterRe  = regexp.MustCompile(`^\s*(?:x\s+|kali\s+)?(?:views?|viewers?|penonton|ditonton|dilihat|tayangan)\b`)
+	viewsKeywordBeforeRe = regexp.MustCompile(`\b(?:views?|viewers?|penonton|ditonton|dilihat|tayangan)\s*:?\s*$`)
+	// thousandsRe matches numbers grouped in thousands, e.g. "10,000" or "1.234.567"
+	thousandsRe = regexp.MustCompile(`^\d{1,3}(?:[.,]\d{3})+$`)
+)
+
+// viewsMultipliers maps magnitude suffixes to their multiplier
+var viewsMultipliers = map[string]float64{
+	"k":      1e3,
+	"rb":     1e3,
+	"ribu":   1e3,
+	"m":      1e6,
+	"jt":     1e6,
+	"juta":   1e6,
+	"b":      1e9,
+	"miliar": 1e9,
+}
+
+// ParseScore extracts a 0-10 rating from texts such as "8.71", "7,5",
+// "Rating 8.5 / 10" or "★ 8". Placeholders and scores outside 0-10 are
+// rejected.
+func ParseScore(text string) (float64, bool) {
+	score, ok := parseScoreNumber(text)
+	if !ok || score < 0 || score > 10 {
+		return 0, false
+	}
+	return score, true
+}
+
+// parseScoreNumber returns the first number in text, whatever its range. A
+// number with a magnitude suffix is a count, not a score.
+func parseScoreNumber(text string) (float64, bool) {
+	if IsPlaceholderText(text) {
+		return 0, false
+	}
+	match := scoreNumberRe.FindStringSubmatch(text)
+	if match == nil || match[2] != "" {
+		return 0, false
+	}
+	return parseDecimal(match[1])
+}
+
+// ParseViews extracts a view count from texts such as "427583 Views",
+// "12.034 Views", "1.2K views" or "1,5 jt". Only a number with a magnitude
+// suffix or next to a views keyword counts, so ranks and ratings elsewhere
+// in the text are skipped; a suffixed number wins, then one followed by the
+// keyword, then one preceded by it. Both "." and "," are accepted as
+// thousands separators; with a magnitude suffix the separator is read as a
+// decimal point, and without one a decimal such as "8.5" is rejected.
+// Placeholders such as "10,000+ views" are rejected.
+func ParseViews(text string) (int64, bool) {
+	if IsPlaceholderText(text) {
+		return 0, false
+	}
+
+	lower := strings.ToLower(CleanText(text))
+	best, bestRank := []int(nil), 3
+	for _, loc := range viewsNumberRe.FindAllStringSubmatchIndex(lower, -1) {
+		rank := 3
+		switch {
+		case loc[4] >= 0:
+			rank = 0
+		case viewsKeywordAfterRe.MatchString(lower[loc[1]:]):
+			rank = 1
+		case viewsKeywordBeforeRe.MatchString(lower[:loc[0]]):
+			rank = 2
+		}
+		if rank < bestRank {
+			best, bestRank = loc, rank
+		}
+	}
+	if best == nil {
+		return 0, false
+	}
+
+	number := lower[best[2]:best[3]]
+	if bestRank > 0 {
+		if thousandsRe.MatchString(number) {
+			number = strings.NewReplacer(".", "", ",", "").Replace(number)
+		} else if strings.ContainsAny(number, ".,") {
+			return 0, false
+		}
+		views, ok := parseDecimal(number)
+		if !ok {
+			return 0, false
+		}
+		return int64(views), true
+	}
+
+	views, ok := parseDecimal(number)
+	if !ok {
+		return 0, false
+	}
+	return int64(math.Round(views * viewsMultipliers[lower[best[4]:best[5]]])), true
+}
+
+// ScoreValue is ParseScore for optional response fields: nil when text holds
+// no score
+func ScoreValue(text string) *float64 {
+	score, ok := ParseScore(text)
+	if !ok {
+		return nil
+	}
+	return &score
+}
+
+// ViewsCount is ParseViews for optional response fields: nil when text holds
+// no view count
+func ViewsCount(text string) *int64 {
+	views, ok := ParseViews(text)
+	if !ok {
+		return nil
+	}
+	return &views
+}
+
+// EpisodeNumberValue is ParseEpisodeNumber for optional response fields: nil
+// when text holds no episode number
+func EpisodeNumberValue(text string) *float64 {
+	number, ok := ParseEpisodeNumber(text)
+	if !ok {
+		return nil
+	}
+	return &number
+}
@@ -0,0 +1,122 @@
+package utils
+
+import "testing"
+
+func TestParseScore(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  float64
+		valid bool
+	}{
+		{"8.71", 8.71, true},
+		{"7.80", 7.8, true},
+		{"7,5", 7.5, true},
+		{"8", 8, true},
+		{"Rating 8.50 / 10", 8.5, true},
+		{"  9.1  ", 9.1, true},
+		{"0", 0, true},
+		{"10", 10, true},
+		{"85", 0, false},
+		{"1,5 jt", 0, false},
+		{"8.5K", 0, false},
+		{"N/A", 0, false},
+		{"?", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseScore(tt.text)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("ParseScore(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestParseViews(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  int64
+		valid bool
+	}{
+		{"427583 Views", 427583, true},
+		{"12034 Views", 12034, true},
+		{"12.034 Views", 12034, true},
+		{"1,234,567 views", 1234567, true},
+		{"1.2K views", 1200, true},
+		{"1,5 rb", 1500, true},
+		{"2 jt penonton", 2000000, true},
+		{"3.4M", 3400000, true},
+		{"Views: 12.034", 12034, true},
+		{"1.234 kali ditonton", 1234, true},
+		{"Rank 1 Views 10k", 10000, true},
+		{"Rating 8.71 / 10", 0, false},
+		{"8.5", 0, false},
+		{"12.5 views", 0, false},
+		{"427583", 0, false},
+		{"0 Views", 0, false},
+		{"10,000+ views", 0, false},
+		{"10,000+ viewers", 0, false},
+		{"Views", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseViews(tt.text)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("ParseViews(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestParseEpisodeNumber(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  float64
+		valid bool
+	}{
+		{"Episode 6", 6, true},
+		{"Eps 12", 12, true},
+		{"Ep. 3", 3, true},
+		{"Episode 12.5", 12.5, true},
+		{"Episode 12,5", 12.5, true},
+		{"1140", 1140, true},
+		{"Dandadan Season 2 Episode 6 Sub Indo", 6, true},
+		{"Unknown", 0, false},
+		{"Movie", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := ParseEpisodeNumber(tt.text)
+			if ok != tt.valid || got != tt.want {
+				t.Errorf("ParseEpisodeNumber(%q) = %v, %v; want %v, %v", tt.text, got, ok, tt.want, tt.valid)
+			}
+		})
+	}
+}
+
+func TestOptionalNumbers(t *testing.T) {
+	if got := ScoreValue("8.71"); got == nil || *got != 8.71 {
+		t.Errorf("ScoreValue(\"8.71\") = %v; want 8.71", got)
+	}
+	if got := ScoreValue(""); got != nil {
+		t.Errorf("ScoreValue(\"\") = %v; want nil", *got)
+	}
+	if got := ViewsCount("427583 Views"); got == nil || *got != 427583 {
+		t.Errorf("ViewsCount(\"427583 Views\") = %v; want 427583", got)
+	}
+	if got := ViewsCount("10,000+ views"); got != nil {
+		t.Errorf("ViewsCount(\"10,000+ views\") = %v; want nil", *got)
+	}
+	if got := EpisodeNumberValue("Episode 6"); got == nil || *got != 6 {
+		t.Errorf("EpisodeNumberValue(\"Episode 6\") = %v; want 6", got)
+	}
+	if got := EpisodeNumberValue("Unknown"); got != nil {
+		t.Errorf("EpisodeNumberValue(\"Unknown\") = %v; want nil", *got)
+	}
+}
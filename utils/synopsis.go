@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Synopsis is a synopsis split into paragraphs, with a sanitized markdown
+// rendering that keeps only bold, italics and http(s) links
+type Synopsis struct {
+	Paragraphs []string
+	Markdown   string
+}
+
+// Text returns the paragraphs separated by blank lines
+func (s Synopsis) Text() string {
+	return strings.Join(s.Paragraphs, "\n\n")
+}
+
+// synopsisBlockTags end the current paragraph
+var synopsisBlockTags = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "section": true, "article": true, "hr": true,
+}
+
+// synopsisSkippedTags never hold synopsis text
+var synopsisSkippedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "noscript": true,
+	"img": true, "button": true, "form": true, "#comment": true,
+}
+
+// markdownEscaper escapes characters that would otherwise read as markdown
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", "&lt;", ">", "&gt;",
+)
+
+// paragraphBreakRe matches the blank lines that separate paragraphs written
+// as plain text
+var paragraphBreakRe = regexp.MustCompile(`\n\s*\n`)
+
+// markdownLineStartRe matches text that would start a heading or a list
+var markdownLineStartRe = regexp.MustCompile(`^(#{1,6}\s|[-+]\s|\d+\.\s)`)
+
+type synopsisParagraph struct {
+	text     string
+	markdown string
+}
+
+type synopsisBuilder struct {
+	current    synopsisParagraph
+	paragraphs []synopsisParagraph
+	breaks     int
+}
+
+// ExtractSynopsis extracts the synopsis held by sel. Block elements, line
+// breaks and blank lines in plain text separate paragraphs, markup other than bold, italics and links is
+// dropped, and paragraphs matching any boilerplate pattern (site credits,
+// "Nonton ... Sub Indo" lines) are removed. Invalid patterns are ignored.
+func ExtractSynopsis(sel *goquery.Selection, boilerplate []string) Synopsis {
+	synopsis := Synopsis{Paragraphs: []string{}}
+	if sel == nil || sel.Length() == 0 {
+		return synopsis
+	}
+
+	builder := &synopsisBuilder{}
+	builder.walk(sel.First())
+	builder.flush()
+
+	patterns := compileBoilerplate(boilerplate)
+	var markdown []string
+	for _, paragraph := range builder.paragraphs {
+		text := CleanText(paragraph.text)
+		if isBoilerplate(text, patterns) {
+			continue
+		}
+		synopsis.Paragraphs = append(synopsis.Paragraphs, text)
+		markdown = append(markdown, CleanText(paragraph.markdown))
+	}
+	synopsis.Markdown = strings.Join(markdown, "\n\n")
+
+	return synopsis
+}
+
+func (b *synopsisBuilder) walk(sel *goquery.Selection) {
+	sel.Contents().Each(func(_ int, node *goquery.Selection) {
+		name := goquery.NodeName(node)
+		switch {
+		case name == "#text":
+			for i, text := range paragraphBreakRe.Split(node.Text(), -1) {
+				if i > 0 {
+					b.flush()
+				}
+				b.appendText(text)
+			}
+		case synopsisSkippedTags[name]:
+		case synopsisBlockTags[name]:
+			b.flush()
+			b.walk(node)
+			b.flush()
+		case name == "b" || name == "strong":
+			b.wrap(node, "**", "**")
+		case name == "i" || name == "em":
+			b.wrap(node, "_", "_")
+		case name == "a":
+			href := strings.TrimSpace(node.AttrOr("href", ""))
+			if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+				b.wrap(node, "[", "]("+strings.NewReplacer("(", "%28", ")", "%29", " ", "%20").Replace(href)+")")
+			} else {
+				b.walk(node)
+			}
+		default:
+			b.walk(node)
+		}
+	})
+}
+
+// appendText adds plain text to the current paragraph, escaping it for the
+// markdown rendering
+func (b *synopsisBuilder) appendText(text string) {
+	md := markdownEscaper.Replace(text)
+	if strings.TrimSpace(b.current.markdown) == "" {
+		md = markdownLineStartRe.ReplaceAllString(strings.TrimLeft(md, " \t\n"), `\$1`)
+	}
+	b.current.text += text
+	b.current.markdown += md
+}
+
+// wrap renders an inline element, surrounding its markdown with prefix and
+// suffix unless it holds no text
+func (b *synopsisBuilder) wrap(node *goquery.Selection, prefix, suffix string) {
+	if CleanText(node.Text()) == "" {
+		return
+	}
+
+	start, breaks := len(b.current.markdown), b.breaks
+	b.walk(node)
+	if b.breaks != breaks {
+		// A block inside the element ended the paragraph
+		return
+	}
+
+	// Markers must touch the words, so surrounding spaces move outside them
+	inner := b.current.markdown[start:]
+	lead := inner[:len(inner)-len(strings.TrimLeft(inner, " \t\n"))]
+	trail := inner[len(strings.TrimRight(inner, " \t\n")):]
+	b.current.markdown = b.current.markdown[:start] + lead + prefix + strings.TrimSpace(inner) + suffix + trail
+}
+
+func (b *synopsisBuilder) flush() {
+	if CleanText(b.current.text) != "" {
+		b.paragraphs = append(b.paragraphs, b.current)
+	}
+	b.current = synopsisParagraph{}
+	b.breaks++
+}
+
+func compileBoilerplate(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		if re, err := regexp.Compile(pattern); err == nil {
+			compiled = append(compiled, re)
+		}
+	}
+	return compiled
+}
+
+func isBoilerplate(text string, patterns []*regexp.Regexp) bool {
+	for _, re := range patterns {
+		if re.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/nabilulilalbab/winbu.tv/config"
+)
+
+func TestExtractSynopsis(t *testing.T) {
+	tests := []struct {
+		name           string
+		html           string
+		wantParagraphs []string
+		wantMarkdown   string
+	}{
+		{
+			name: "paragraphs",
+			html: `<div class="mli-desc"><p>Kerajaan Suci telah damai
+				bertahun-tahun.</p><p>Namun ancaman baru datang.</p></div>`,
+			wantParagraphs: []string{"Kerajaan Suci telah damai bertahun-tahun.", "Namun ancaman baru datang."},
+			wantMarkdown:   "Kerajaan Suci telah damai bertahun-tahun.\n\nNamun ancaman baru datang.",
+		},
+		{
+			name:           "line breaks without paragraphs",
+			html:           `<div class="mli-desc">Baris pertama.<br>Baris kedua.<br/><br/>Baris ketiga.</div>`,
+			wantParagraphs: []string{"Baris pertama.", "Baris kedua.", "Baris ketiga."},
+			wantMarkdown:   "Baris pertama.\n\nBaris kedua.\n\nBaris ketiga.",
+		},
+		{
+			// Markup saved from an episode page: plain text split by blank lines
+			name: "plain text paragraphs",
+			html: `<div class="mli-desc">Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni
+[Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni (Easygoing Territory Defense by the Optimistic Lord: Production Magic Turns a Nameless Village into the Strongest Fortified City)]
+
+Van, putra keempat dari bangsawan kuat, baru berusia dua tahun ketika ia mengingat kehidupan masa lalunya sebagai seorang pekerja kantoran di Jepang. Dengan otak orang dewasa dalam tubuh anak kecil, ia dianggap sebagai anak jenius… sampai ia memperlihatkan skill “Sihir Produksi” saat berusia delapan tahun. Dalam keluarga yang mengutamakan sihir ofensif, kemampuan merajin ini dianggap tidak berguna dalam pertempuran, dan Van pun diasingkan untuk mengurus sebuah kota desa kecil sebagai bentuk penghinaan. Dengan hanya ditemani pelayan pribadinya, ingatan akan kehidupan lamanya, dan sihirnya yang dianggap “tak berguna”, mampukah Van mengubah nasib desa kecil ini—dan dirinya sendiri?</div>
+					</div>`,
+			wantParagraphs: []string{
+				"Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni [Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni (Easygoing Territory Defense by the Optimistic Lord: Production Magic Turns a Nameless Village into the Strongest Fortified City)]",
+				"Van, putra keempat dari bangsawan kuat, baru berusia dua tahun ketika ia mengingat kehidupan masa lalunya sebagai seorang pekerja kantoran di Jepang. Dengan otak orang dewasa dalam tubuh anak kecil, ia dianggap sebagai anak jenius… sampai ia memperlihatkan skill “Sihir Produksi” saat berusia delapan tahun. Dalam keluarga yang mengutamakan sihir ofensif, kemampuan merajin ini dianggap tidak berguna dalam pertempuran, dan Van pun diasingkan untuk mengurus sebuah kota desa kecil sebagai bentuk penghinaan. Dengan hanya ditemani pelayan pribadinya, ingatan akan kehidupan lamanya, dan sihirnya yang dianggap “tak berguna”, mampukah Van mengubah nasib desa kecil ini—dan dirinya sendiri?",
+			},
+			wantMarkdown: "Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni \\[Okiraku Ryoushu no Tanoshii Ryouchi Bouei: Seisankei Majutsu de Na mo Naki Mura wo Saikyou no Jousai Toshi ni (Easygoing Territory Defense by the Optimistic Lord: Production Magic Turns a Nameless Village into the Strongest Fortified City)\\]\n\nVan, putra keempat dari bangsawan kuat, baru berusia dua tahun ketika ia mengingat kehidupan masa lalunya sebagai seorang pekerja kantoran di Jepang. Dengan otak orang dewasa dalam tubuh anak kecil, ia dianggap sebagai anak jenius… sampai ia memperlihatkan skill “Sihir Produksi” saat berusia delapan tahun. Dalam keluarga yang mengutamakan sihir ofensif, kemampuan merajin ini dianggap tidak berguna dalam pertempuran, dan Van pun diasingkan untuk mengurus sebuah kota desa kecil sebagai bentuk penghinaan. Dengan hanya ditemani pelayan pribadinya, ingatan akan kehidupan lamanya, dan sihirnya yang dianggap “tak berguna”, mampukah Van mengubah nasib desa kecil ini—dan dirinya sendiri?",
+		},
+		{
+			name: "boilerplate",
+			html: `<div class="mli-desc"><p>Nonton Overlord Episode 1 Sub Indo di Winbu</p>
+				<p>Ainz menjelajahi dunia baru.</p><p>(Source: MAL Rewrite)</p><p>Streaming di Winbu.TV</p><p>Download Overlord batch di Winbu.net!</p><p>Winbu.TV</p></div>`,
+			wantParagraphs: []string{"Ainz menjelajahi dunia baru."},
+			wantMarkdown:   "Ainz menjelajahi dunia baru.",
+		},
+		{
+			name:           "site mentioned in synopsis",
+			html:           `<div class="mli-desc"><p>Ainz menjelajahi dunia baru. Episode baru tayang setiap minggu di Winbu.</p></div>`,
+			wantParagraphs: []string{"Ainz menjelajahi dunia baru. Episode baru tayang setiap minggu di Winbu."},
+			wantMarkdown:   "Ainz menjelajahi dunia baru. Episode baru tayang setiap minggu di Winbu.",
+		},
+		{
+			name:           "credit words in synopsis",
+			html:           `<div class="mli-desc"><p>Streaming anime di Winbu adalah hal yang menyenangkan bagi tokoh utama.</p></div>`,
+			wantParagraphs: []string{"Streaming anime di Winbu adalah hal yang menyenangkan bagi tokoh utama."},
+			wantMarkdown:   "Streaming anime di Winbu adalah hal yang menyenangkan bagi tokoh utama.",
+		},
+		{
+			name:           "inline markup",
+			html:           `<div class="mli-desc"><p>Adaptasi <strong>manga populer</strong> karya <em>Oda</em>, lihat <a href="https://myanimelist.net/anime/21">MAL</a>.</p></div>`,
+			wantParagraphs: []string{"Adaptasi manga populer karya Oda, lihat MAL."},
+			wantMarkdown:   "Adaptasi **manga populer** karya _Oda_, lihat [MAL](https://myanimelist.net/anime/21).",
+		},
+		{
+			name:           "unsafe markup",
+			html:           `<div class="mli-desc"><p>Skor 5*5 <script>alert(1)</script><a href="javascript:alert(1)">klik</a> [spoiler]</p><p># 1. bukan judul</p></div>`,
+			wantParagraphs: []string{"Skor 5*5 klik [spoiler]", "# 1. bukan judul"},
+			wantMarkdown:   "Skor 5\\*5 klik \\[spoiler\\]\n\n\\# 1. bukan judul",
+		},
+		{
+			name:           "empty",
+			html:           `<div class="mli-desc"> </div>`,
+			wantParagraphs: []string{},
+			wantMarkdown:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			got := ExtractSynopsis(doc.Find(".mli-desc"), config.DefaultSynopsisBoilerplate)
+			if !reflect.DeepEqual(got.Paragraphs, tt.wantParagraphs) {
+				t.Errorf("Paragraphs = %q; want %q", got.Paragraphs, tt.wantParagraphs)
+			}
+			if got.Markdown != tt.wantMarkdown {
+				t.Errorf("Markdown = %q; want %q", got.Markdown, tt.wantMarkdown)
+			}
+		})
+	}
+}